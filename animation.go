@@ -0,0 +1,121 @@
+package glot
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AnimationOptions are the options of an animation, see NewAnimation.
+type AnimationOptions struct {
+	Dimensions int           // Dimensions of the plots of the frames, 2 when 0
+	Width      int           // Width of the frames in pixels, gnuplot's default when 0
+	Height     int           // Height of the frames in pixels
+	Delay      time.Duration // Time each frame is shown, 100ms when 0
+	Loop       int           // Number of times the animation is played, 0 to repeat it forever
+	Debug      bool          // Print the commands sent to gnuplot
+}
+
+// Animation is a sequence of plots, the frames, saved as an animated GIF.
+// Every frame is a new plot set up by a function, with its own PointGroups and settings.
+type Animation struct {
+	options AnimationOptions
+	frames  []func(p *Plot)
+}
+
+// NewAnimation makes an animation without frames.
+//
+// Usage
+//  anim, _ := glot.NewAnimation(glot.AnimationOptions{Width: 640, Height: 480, Delay: 50 * time.Millisecond})
+//  for step := 0; step < 100; step++ {
+//  	step := step
+//  	anim.AddFrame(func(p *glot.Plot) {
+//  		p.SetTitle(fmt.Sprintf("t = %d", step))
+//  		p.SetYrange(-1, 1)
+//  		p.AddPointGroup("Wave", "lines", wave(step))
+//  	})
+//  }
+//  anim.Save("wave.gif")
+func NewAnimation(options AnimationOptions) (*Animation, error) {
+	if options.Dimensions == 0 {
+		options.Dimensions = 2
+	}
+	if options.Dimensions < 1 || options.Dimensions > 3 {
+		return nil, &gnuplotError{err: fmt.Sprintf("invalid number of dims '%v'", options.Dimensions), kind: ErrInvalidDimensions}
+	}
+	if options.Delay == 0 {
+		options.Delay = 100 * time.Millisecond
+	}
+	if options.Delay < 0 || options.Loop < 0 {
+		return nil, &gnuplotError{err: fmt.Sprintf("The delay %v and the loop count %d must not be negative.", options.Delay, options.Loop)}
+	}
+	return &Animation{options: options}, nil
+}
+
+// AddFrame appends a frame, drawn by calling frame with a new plot when the animation is saved.
+// Frames without PointGroups are left out.
+func (anim *Animation) AddFrame(frame func(p *Plot)) {
+	anim.frames = append(anim.frames, frame)
+}
+
+// Save draws the frames with gnuplot's gif terminal and writes the animation to filename.
+func (anim *Animation) Save(filename string) error {
+	return anim.SaveContext(context.Background(), filename)
+}
+
+// SaveContext is like Save but kills gnuplot when the context is done.
+func (anim *Animation) SaveContext(ctx context.Context, filename string) error {
+	plots, err := anim.render(ctx)
+	defer closePlots(plots)
+	if err != nil {
+		return err
+	}
+	// gif delays are in hundredths of a second.
+	delay := int(anim.options.Delay / (10 * time.Millisecond))
+	if delay < 1 {
+		delay = 1
+	}
+	options := TerminalOptions{Width: float64(anim.options.Width), Height: float64(anim.options.Height),
+		Extra: fmt.Sprintf("animate delay %d loop %d", delay, anim.options.Loop)}
+	commands := []string{terminalCommand("gif", options), "set output " + quote(filename)}
+	for _, plot := range plots {
+		commands = append(commands, frameCommands(plot)...)
+	}
+	commands = append(commands, "unset output")
+	return runScript(ctx, commands, nil, debugLogger(anim.options.Debug))
+}
+
+// render sets up the plots of the frames, leaving out the empty ones.
+func (anim *Animation) render(ctx context.Context) ([]*Plot, error) {
+	var plots []*Plot
+	for _, frame := range anim.frames {
+		plot, err := newRecordingPlot(ctx, anim.options.Dimensions, anim.options.Debug)
+		if err != nil {
+			return plots, err
+		}
+		frame(plot)
+		if plot.empty() {
+			plot.Close()
+			continue
+		}
+		plots = append(plots, plot)
+	}
+	if len(plots) == 0 {
+		return nil, &gnuplotError{err: "The animation has no frames with curves."}
+	}
+	return plots, nil
+}
+
+// frameCommands returns the commands drawing a plot from scratch.
+func frameCommands(plot *Plot) []string {
+	commands := []string{"reset"}
+	commands = append(commands, plot.settings()...)
+	return append(commands, plot.plotAll())
+}
+
+// closePlots closes the plots, removing their data files.
+func closePlots(plots []*Plot) {
+	for _, plot := range plots {
+		plot.Close()
+	}
+}
@@ -0,0 +1,34 @@
+package glot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnimationFrames(t *testing.T) {
+	_, err := NewAnimation(AnimationOptions{Delay: -time.Second})
+	if err == nil {
+		t.Error("Expected an error for a negative delay.")
+	}
+	anim, _ := NewAnimation(AnimationOptions{})
+	if anim.Save("empty.gif") == nil {
+		t.Error("Expected an error for an animation without frames.")
+	}
+	anim.AddFrame(func(p *Plot) {})
+	for i := 0; i < 3; i++ {
+		i := i
+		anim.AddFrame(func(p *Plot) {
+			p.SetTitle("frame")
+			p.AddPointGroup("Sample1", "lines", []float64{float64(i), 1, 2})
+		})
+	}
+	plots, err := anim.render(nil)
+	defer closePlots(plots)
+	if err != nil || len(plots) != 3 {
+		t.Fatal("Expected 3 frames, got ", len(plots), err)
+	}
+	commands := frameCommands(plots[0])
+	if commands[0] != "reset" || commands[1] != `set title "frame"` {
+		t.Error("Unexpected commands of the frame ", commands)
+	}
+}
@@ -0,0 +1,115 @@
+package glot
+
+import "fmt"
+
+// Coords is a coordinate system of gnuplot for positions of annotations.
+type Coords string
+
+// The coordinate systems of annotations.
+const (
+	First  Coords = "first"  // The x1 and y1 axes, the coordinates of the data
+	Second Coords = "second" // The x2 and y2 axes
+	Graph  Coords = "graph"  // 0,0 is the bottom left and 1,1 the top right of the graph
+	Screen Coords = "screen" // 0,0 is the bottom left and 1,1 the top right of the image
+)
+
+// Annotation is a label, arrow or shape added to a plot, which can be removed with RemoveAnnotation.
+// Annotations are settings of the plot, so they are kept after ResetPlot.
+type Annotation struct {
+	kind string // label, arrow or object
+	tag  int
+}
+
+// AddLabel writes the text at x,y in the coordinates of the data.
+//
+// Usage
+//  label, _ := plot.AddLabel("peak", 3, 4.2)
+//  plot.RemoveAnnotation(label)
+func (plot *Plot) AddLabel(text string, x, y float64) (*Annotation, error) {
+	plot.mu.Lock()
+	quoted := plot.text(text)
+	plot.mu.Unlock()
+	return plot.annotate("label", "%s at first %v,%v", quoted, x, y)
+}
+
+// AddArrow draws an arrow from x1,y1 to x2,y2 in the coordinates of the data.
+// The style holds any options of gnuplot's set arrow, like "nohead lw 2 lc rgb \"red\"",
+// or is empty for the default arrow.
+func (plot *Plot) AddArrow(x1, y1, x2, y2 float64, style string) (*Annotation, error) {
+	return plot.annotate("arrow", "from first %v,%v to first %v,%v %s", x1, y1, x2, y2, style)
+}
+
+// AddRect draws a rectangle with the corners x1,y1 and x2,y2 in the coordinate system coords.
+// The style holds any options of gnuplot's set object, like "fc rgb \"yellow\" fs transparent solid 0.3 behind".
+//
+// Usage
+//  plot.AddRect(0, 0, 1, 0.1, glot.Graph, "fc rgb \"#eeeeee\" fs solid behind")
+func (plot *Plot) AddRect(x1, y1, x2, y2 float64, coords Coords, style string) (*Annotation, error) {
+	return plot.annotate("object", "rect from %s %v,%v to %s %v,%v %s", coords, x1, y1, coords, x2, y2, style)
+}
+
+// AddCircle draws a circle centered at x,y with the radius r in the coordinate system coords.
+// The radius is measured along the x-axis.
+func (plot *Plot) AddCircle(x, y, r float64, coords Coords, style string) (*Annotation, error) {
+	return plot.annotate("object", "circle at %s %v,%v size %s %v %s", coords, x, y, coords, r, style)
+}
+
+// AddHLine draws a horizontal line across the graph at y, like a threshold.
+// The style holds any options of gnuplot's set arrow, like "lc rgb \"red\" dt 2".
+//
+// Usage
+//  plot.AddHLine(0.95, "lc rgb \"red\" dt 2")
+func (plot *Plot) AddHLine(y float64, style string) (*Annotation, error) {
+	return plot.annotate("arrow", "from graph 0, first %v to graph 1, first %v nohead %s", y, y, style)
+}
+
+// AddVLine draws a vertical line across the graph at x, like the time of an event.
+func (plot *Plot) AddVLine(x float64, style string) (*Annotation, error) {
+	return plot.annotate("arrow", "from first %v, graph 0 to first %v, graph 1 nohead %s", x, x, style)
+}
+
+// AddBand shades the horizontal band between y1 and y2 behind the curves, like a target range.
+// The style holds any options of gnuplot's set object, a light gray fill when it is empty.
+//
+// Usage
+//  plot.AddBand(40, 60, "")
+//  plot.AddBand(90, 100, "fc rgb \"red\" fs transparent solid 0.2 noborder")
+func (plot *Plot) AddBand(y1, y2 float64, style string) (*Annotation, error) {
+	if style == "" {
+		style = "fc rgb \"#cccccc\" fs transparent solid 0.3 noborder"
+	}
+	return plot.annotate("object", "rect from graph 0, first %v to graph 1, first %v %s behind", y1, y2, style)
+}
+
+// annotate adds an annotation of the kind with a new tag and draws the plot again.
+func (plot *Plot) annotate(kind string, format string, a ...interface{}) (*Annotation, error) {
+	plot.mu.Lock()
+	annotation := &Annotation{kind: kind, tag: plot.nextTag()}
+	plot.mu.Unlock()
+	err := plot.set(annotation.key(), "set %s %d %s", kind, annotation.tag, fmt.Sprintf(format, a...))
+	if err != nil {
+		return nil, err
+	}
+	return annotation, plot.redraw()
+}
+
+func (annotation *Annotation) key() string {
+	return fmt.Sprintf("%s %d", annotation.kind, annotation.tag)
+}
+
+// RemoveAnnotation removes a label, arrow or shape from the plot and draws the plot again.
+func (plot *Plot) RemoveAnnotation(annotation *Annotation) error {
+	plot.mu.Lock()
+	for i, option := range plot.options {
+		if option.key == annotation.key() {
+			plot.options = append(plot.options[:i], plot.options[i+1:]...)
+			break
+		}
+	}
+	plot.mu.Unlock()
+	err := plot.Cmd("unset %s %d", annotation.kind, annotation.tag)
+	if err != nil {
+		return err
+	}
+	return plot.redraw()
+}
@@ -0,0 +1,24 @@
+package glot
+
+import "testing"
+
+func TestAnnotations(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	label, _ := plot.AddLabel("peak", 3, 4.2)
+	rect, _ := plot.AddRect(0, 0, 1, 0.1, Graph, "behind")
+	if plot.options[1].cmd != "set object 2 rect from graph 0,0 to graph 1,0.1 behind" {
+		t.Error("Unexpected rectangle ", plot.options[1].cmd)
+	}
+	plot.RemoveAnnotation(label)
+	if len(plot.options) != 1 || plot.options[0].key != rect.key() {
+		t.Error("Expected only the rectangle to be kept, got ", plot.options)
+	}
+}
+
+func TestAddHLine(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	plot.AddHLine(0.95, "dt 2")
+	if plot.options[0].cmd != "set arrow 1 from graph 0, first 0.95 to graph 1, first 0.95 nohead dt 2" {
+		t.Error("Unexpected line ", plot.options[0].cmd)
+	}
+}
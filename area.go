@@ -0,0 +1,116 @@
+package glot
+
+import "fmt"
+
+// AreaData holds an area of an area chart, drawn with the filledcurves style.
+// The area is filled between Y and Y2, or between Y and the x-axis when Y2 is nil.
+type AreaData struct {
+	X     []float64
+	Y     []float64
+	Y2    []float64
+	Alpha float64 // Opacity of the fill from 0 to 1, opaque when 0
+}
+
+// AddArea adds the area between the curve y of x and the x-axis.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddArea("Rainfall", []float64{1, 2, 3, 4}, []float64{3, 5, 2, 4})
+//  plot.AddPointGroup("Range", "filledcurves", glot.AreaData{X: x, Y: low, Y2: high, Alpha: 0.3})
+func (plot *Plot) AddArea(name string, x, y []float64) error {
+	return plot.AddPointGroup(name, "filledcurves", AreaData{X: x, Y: y})
+}
+
+// AddAreaBetween adds the area between the curves y1 and y2 of x, like a confidence band.
+func (plot *Plot) AddAreaBetween(name string, x, y1, y2 []float64) error {
+	return plot.AddPointGroup(name, "filledcurves", AreaData{X: x, Y: y1, Y2: y2})
+}
+
+// StackedArea returns the cumulative sums of the series: the i-th returned
+// series is the sum of the series 0 to i.
+func StackedArea(series [][]float64) [][]float64 {
+	stacked := make([][]float64, len(series))
+	for i, values := range series {
+		stacked[i] = make([]float64, len(values))
+		for j, v := range values {
+			stacked[i][j] = v
+			if i > 0 && j < len(stacked[i-1]) {
+				stacked[i][j] += stacked[i-1][j]
+			}
+		}
+	}
+	return stacked
+}
+
+// AddStackedArea adds a stacked area chart, with an area per series on top of the previous series.
+// The names are the names of the PointGroups of the series.
+//
+// Usage
+//  x := []float64{2015, 2016, 2017}
+//  plot.AddStackedArea([]string{"Coal", "Gas", "Solar"}, x, [][]float64{{5, 4, 3}, {2, 3, 3}, {0.5, 1, 2}})
+func (plot *Plot) AddStackedArea(names []string, x []float64, series [][]float64) error {
+	if len(names) != len(series) {
+		return &gnuplotError{err: fmt.Sprintf("The number of names and series are not same.")}
+	}
+	stacked := StackedArea(series)
+	bottom := make([]float64, len(x))
+	for i, top := range stacked {
+		err := plot.AddPointGroup(names[i], "filledcurves", AreaData{X: x, Y: bottom, Y2: top})
+		if err != nil {
+			return err
+		}
+		bottom = top
+	}
+	return nil
+}
+
+func (plot *Plot) plotArea(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(AreaData)
+	columns := [][]float64{data.X, data.Y}
+	option := " x1"
+	if data.Y2 != nil {
+		columns = append(columns, data.Y2)
+		option = ""
+	}
+	for _, column := range columns[1:] {
+		if len(column) != len(data.X) {
+			return &gnuplotError{err: fmt.Sprintf("The length of the x-axis array and y-axis arrays are not same.")}
+		}
+	}
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+	err = writeText(f, columns...)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	using := "1:2"
+	if data.Y2 != nil {
+		using = "1:2:3"
+	}
+	if data.Alpha > 0 && pointGroup.fillStyle == "" {
+		pointGroup.fillStyle = fmt.Sprintf("transparent solid %v", data.Alpha)
+	}
+	pointGroup.style = "filledcurves"
+
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("%s %s using %s with filledcurves%s", cmd, quote(fname), using, option)
+	} else {
+		line = fmt.Sprintf("%s %s using %s title %s with filledcurves%s",
+			cmd, quote(fname), using, plot.text(pointGroup.name), option)
+	}
+	return plot.sendPlotLine(pointGroup, line)
+}
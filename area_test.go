@@ -0,0 +1,18 @@
+package glot
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStackedArea(t *testing.T) {
+	stacked := StackedArea([][]float64{{1, 2}, {3, 4}, {5, 6}})
+	if !reflect.DeepEqual(stacked, [][]float64{{1, 2}, {4, 6}, {9, 12}}) {
+		t.Error("Unexpected cumulative sums ", stacked)
+	}
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddStackedArea([]string{"A", "B"}, []float64{1, 2}, [][]float64{{1, 2}, {3, 4}})
+	if err != nil || plot.nplots != 2 {
+		t.Error("Expected two areas, got ", err)
+	}
+}
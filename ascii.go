@@ -0,0 +1,23 @@
+package glot
+
+import (
+	"fmt"
+	"io"
+)
+
+// RenderASCII draws the plot as text with gnuplot's dumb terminal and writes it to w,
+// width characters wide and height lines high, or 79 by 24 when they are 0.
+// This prints quick charts in terminals and logs of command line tools.
+//
+// Usage
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  plot.RenderASCII(os.Stdout, 80, 25)
+func (plot *Plot) RenderASCII(w io.Writer, width, height int) error {
+	if plot.empty() {
+		return &gnuplotError{err: fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	}
+	if width <= 0 || height <= 0 {
+		width, height = 79, 24
+	}
+	return plot.renderWith(w, fmt.Sprintf("set terminal dumb size %d,%d", width, height))
+}
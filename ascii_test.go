@@ -0,0 +1,20 @@
+package glot
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRenderASCII(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	var buf bytes.Buffer
+	if plot.RenderASCII(&buf, 80, 25) == nil {
+		t.Error("Expected an error for a plot without curves.")
+	}
+	plot.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	err := plot.RenderASCII(&buf, 0, 0)
+	if err != nil {
+		t.Error(err)
+	}
+}
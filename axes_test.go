@@ -0,0 +1,83 @@
+package glot
+
+import "testing"
+
+// lastCmd returns the last command sent to gnuplot.
+func lastCmd(plot *Plot) string {
+	return plot.history[len(plot.history)-1]
+}
+
+func TestSetXRange(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDimensions(3), WithDryRun())
+	defer plot.Close()
+	plot.SetXRange(-0.5, 3.5)
+	if cmd := lastCmd(plot); cmd != "set xrange [-0.5:3.5]" {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetYRange(0, 1e6)
+	if cmd := lastCmd(plot); cmd != "set yrange [0:1e+06]" {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetZRange(-1, 1)
+	if cmd := lastCmd(plot); cmd != "set zrange [-1:1]" {
+		t.Error("Unexpected command ", cmd)
+	}
+	if r := plot.config.XRange; r == nil || r.Min != -0.5 || r.Max != 3.5 {
+		t.Error("Expected the x range to be kept in the settings, got ", r)
+	}
+}
+
+func TestSetLogScale(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	plot.SetLogScale("xy", 2)
+	if cmd := lastCmd(plot); cmd != "set logscale xy 2" {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetLogScale("y", 2.5)
+	if cmd := lastCmd(plot); cmd != "set logscale y 2.5" {
+		t.Error("Unexpected command ", cmd)
+	}
+	if !plot.hasSetting("logscale xy") || !plot.hasSetting("logscale y") {
+		t.Error("Expected the log scales to be kept, got ", plot.options)
+	}
+}
+
+func TestSetXTics(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	plot.SetXTics(0.5)
+	if cmd := lastCmd(plot); cmd != "set xtics 0.5" {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetYTics(10)
+	if cmd := lastCmd(plot); cmd != "set ytics 10" {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetXTicLabels(Tic{Position: 1, Label: "Mon"}, Tic{Position: 2, Label: `"Tue"`})
+	if cmd := lastCmd(plot); cmd != `set xtics ("Mon" 1, "\"Tue\"" 2)` {
+		t.Error("Unexpected command ", cmd)
+	}
+	for _, option := range plot.options {
+		if option.key == "xtics" && option.cmd != `set xtics ("Mon" 1, "\"Tue\"" 2)` {
+			t.Error("Expected the tic labels to replace the interval, got ", plot.options)
+		}
+	}
+}
+
+func TestSetGrid(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	plot.SetGrid()
+	if cmd := lastCmd(plot); cmd != "set grid" {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetGrid(false)
+	if cmd := lastCmd(plot); cmd != "unset grid" {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetGrid(true)
+	if cmd := lastCmd(plot); cmd != "set grid" {
+		t.Error("Unexpected command ", cmd)
+	}
+}
@@ -0,0 +1,46 @@
+package glot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+)
+
+// SetBinary makes the plot write the data of PointGroups added afterwards in
+// gnuplot's binary format instead of text. This makes the data files smaller
+// and much faster to write and read for large data sets.
+// Only 1-d, 2-d and 3-d point data is written in binary, the other data types
+// like CandlesticksData are always written as text.
+//
+// Usage
+//  plot.SetBinary(true)
+//  plot.AddPointGroup("Samples", "dots", [][]float64{x, y})
+func (plot *Plot) SetBinary(on bool) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.binary = on
+}
+
+// binaryFormat returns the options of a plot command reading a binary
+// data file with the given number of float64 columns.
+func binaryFormat(columns int) string {
+	using := []string{"1", "2", "3"}[:columns]
+	return "binary format='" + strings.Repeat("%float64", columns) +
+		"' endian=little using " + strings.Join(using, ":")
+}
+
+// writeBinary writes the rows of the columns as little endian float64 records.
+// All columns must have the same length.
+func writeBinary(w io.Writer, columns ...[]float64) error {
+	bw := bufio.NewWriter(w)
+	var buf [8]byte
+	for i := range columns[0] {
+		for _, column := range columns {
+			binary.LittleEndian.PutUint64(buf[:], math.Float64bits(column[i]))
+			bw.Write(buf[:])
+		}
+	}
+	return bw.Flush()
+}
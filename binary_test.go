@@ -0,0 +1,14 @@
+package glot
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteBinary(t *testing.T) {
+	var buf bytes.Buffer
+	writeBinary(&buf, []float64{1, 2}, []float64{3, 4})
+	if buf.Len() != 32 {
+		t.Error("Expected 4 float64 values of 8 bytes, got ", buf.Len())
+	}
+}
@@ -0,0 +1,59 @@
+package glot
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestBoxPlotData(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	err = plot.AddPointGroup("Latency", "boxplot", BoxPlotData{
+		Groups:       [][]float64{{1, 2, 3}, {4, 5}},
+		Labels:       []string{"api", "db"},
+		WhiskerRange: 2,
+		HideOutliers: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fname := plot.DataFiles()["Latency"]
+	data, err := ioutil.ReadFile(fname)
+	if err != nil || string(data) != "1\n2\n3\n\n\n4\n5\n" {
+		t.Errorf("Expected every group in a data block of its own, got %q, %v", data, err)
+	}
+	script := plot.DumpScript()
+	for _, expected := range []string{
+		"set style boxplot range 2 nooutliers",
+		`set xtics ("api" 1, "db" 2)`,
+		quote(fname) + ` index 0 using (1):1 title "Latency" with boxplot`,
+		quote(fname) + ` index 1 using (2):1 notitle with boxplot`,
+	} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("Expected %q in the script:\n%s", expected, script)
+		}
+	}
+}
+
+func TestBoxPlotDataDefaults(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	err = plot.AddPointGroup("", "boxplot", BoxPlotData{Groups: [][]float64{{1, 2, 3}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := plot.DumpScript()
+	if !strings.Contains(script, "set style boxplot range 1.5 outliers") {
+		t.Errorf("Expected the default whiskers and outliers in the script:\n%s", script)
+	}
+	if strings.Contains(script, "set xtics") {
+		t.Errorf("Expected no tic labels without labels:\n%s", script)
+	}
+}
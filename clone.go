@@ -0,0 +1,229 @@
+package glot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// Clone returns a copy of the plot drawn by a new gnuplot process.
+// The commands sent to the plot so far, except the ones writing output files,
+// are sent to the new process, and the PointGroups are copied together with their
+// data files, so the clone and the plot can be changed independently afterwards.
+// The clone of a plot of a PlotterPool gets its own gnuplot process,
+// the clone of a dry-run plot is a dry-run plot too.
+//
+// Usage
+//  plot.AddPointGroup("Sample1", "lines", []int32{51, 8, 4, 11})
+//  variant, _ := plot.Clone()
+//  variant.SetTitle("Variant")
+//  variant.SavePlot("variant.png")
+func (plot *Plot) Clone() (*Plot, error) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	clone, err := newPlot(plot.ctx, plot.dimensions, plot.debug)
+	if err != nil {
+		return nil, err
+	}
+	clone.logger.Store(loggerBox{plot.getLogger()})
+	if plot.plotter != nil {
+		return nil, &gnuplotError{err: "A plot with a Plotter of its own can't be cloned."}
+	}
+	if plot.dryRun {
+		clone.dryRun = true
+	} else {
+		clone.stable = plot.stable
+		clone.proc, err = newPlotterProc(clone.ctx, plot.proc.handle.Path, contains(plot.proc.handle.Args, "-persist"), clone.gnuplotArgs()...)
+		if err != nil {
+			return nil, err
+		}
+		clone.setFinalizer()
+	}
+	clone.gnuplot = plot.gnuplot
+	clone.plotcmd = plot.plotcmd
+	clone.format = plot.format
+	clone.style = plot.style
+	clone.title = plot.title
+	clone.binary = plot.binary
+	clone.inline = plot.inline
+	clone.files = plot.files
+	for name, content := range plot.blocks {
+		clone.blocks[name] = content
+	}
+	clone.maxPoints = plot.maxPoints
+	clone.sampling = plot.sampling
+	clone.terminal = plot.terminal
+	clone.screen = plot.screen
+	clone.winTitle = plot.winTitle
+	clone.persist = plot.persist
+	clone.tempDir = plot.tempDir
+	clone.config = plot.config
+	clone.options = append([]setting{}, plot.options...)
+	clone.tags = plot.tags
+	clone.passthru = plot.passthru
+	clone.colors = plot.colors
+	clone.background = plot.background
+	clone.nplots = plot.nplots
+
+	plot.cmdMu.Lock()
+	history := append([]string{}, plot.history...)
+	clone.noRestart = plot.noRestart
+	plot.cmdMu.Unlock()
+
+	copies := make(map[*PointGroup]*PointGroup)
+	for name, pointGroup := range plot.PointGroup {
+		copied := *pointGroup
+		copied.plot = clone
+		copied.castedData = copyData(pointGroup.castedData)
+		copied.owned = true
+		copied.specs = append([]string{}, pointGroup.specs...)
+		if _, owned := plot.tmpfiles[pointGroup.fname]; owned && !plot.sharesData(pointGroup) {
+			err = clone.copyDataFile(&copied)
+			if err != nil {
+				clone.Close()
+				return nil, err
+			}
+		}
+		clone.PointGroup[name] = &copied
+		copies[pointGroup] = &copied
+	}
+	plot.relinkSources(copies)
+
+	for _, cmd := range history {
+		if writesOutput(cmd) {
+			continue
+		}
+		err = clone.Cmd("%s", cmd)
+		if err != nil {
+			clone.Close()
+			return nil, err
+		}
+	}
+	if clone.nplots > 0 {
+		err = clone.Cmd("%s", clone.plotAllCmd())
+	}
+	return clone, err
+}
+
+// copyDataFile gives a copied PointGroup a copy of its data file.
+func (plot *Plot) copyDataFile(pointGroup *PointGroup) error {
+	content, err := ioutil.ReadFile(pointGroup.fname)
+	if err != nil {
+		return err
+	}
+	f, err := plot.tempFile()
+	if err != nil {
+		return err
+	}
+	plot.tmpfiles[f.Name()] = f
+	_, err = f.Write(content)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	pointGroup.replaceInSpecs(quote(pointGroup.fname), quote(f.Name()), -1)
+	pointGroup.fname = f.Name()
+	return nil
+}
+
+// copyData copies the slices that AppendPoint and friends append to,
+// the other data types are never changed in place.
+func copyData(data interface{}) interface{} {
+	switch data := data.(type) {
+	case []float64:
+		return append([]float64{}, data...)
+	case [][]float64:
+		copied := make([][]float64, len(data))
+		for i := range data {
+			copied[i] = append([]float64{}, data[i]...)
+		}
+		return copied
+	}
+	return data
+}
+
+// templateKeys are the settings that make up the style of a plot, see PlotTemplate.
+var templateKeys = []string{
+	"grid", "key", "palette", "boxwidth", "size", "jitter", "border",
+	"tics textcolor", "key textcolor", "title textcolor", "xlabel textcolor", "ylabel textcolor", "zlabel textcolor",
+	"tics font", "key font", "title font", "xlabel font", "ylabel font", "zlabel font",
+	"lmargin", "rmargin", "tmargin", "bmargin", "size ratio", "xtics rotate",
+}
+
+// PlotTemplate holds the styling of a plot, like its palette, grid, legend, fonts, default style,
+// output format and terminal options, but none of its titles, labels, ranges or data.
+// It lets many plots share a house chart style.
+//
+// Usage
+//  house, _ := glot.NewPlot(2, false, false)
+//  house.SetGrid()
+//  house.SetPalette("viridis")
+//  house.SetLegend(glot.LegendOptions{Position: "top left", Box: true})
+//  template := house.Template()
+//
+//  plot, _ := template.NewPlot(2, false, false)
+//  plot.AddPointGroup("Sample1", "lines", []int32{51, 8, 4, 11})
+type PlotTemplate struct {
+	options    []setting
+	style      string
+	format     string
+	terminal   TerminalOptions
+	colors     []string
+	background string // The set object command of the background without its tag
+}
+
+// Template captures the styling of the plot in a PlotTemplate.
+func (plot *Plot) Template() *PlotTemplate {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	template := &PlotTemplate{style: plot.style, format: plot.format, terminal: plot.terminal, colors: plot.colors}
+	for _, option := range plot.options {
+		if contains(templateKeys, option.key) {
+			template.options = append(template.options, option)
+		}
+		if option.key == "background" && strings.HasPrefix(option.cmd, "set object ") {
+			// The tag of the background belongs to this plot, other plots get their own.
+			template.background = strings.SplitN(option.cmd, " ", 4)[3]
+		}
+	}
+	return template
+}
+
+// Apply gives the plot the styling of the template.
+func (template *PlotTemplate) Apply(plot *Plot) error {
+	plot.mu.Lock()
+	plot.style = template.style
+	plot.format = template.format
+	plot.terminal = template.terminal
+	plot.colors = template.colors
+	options := template.options
+	if template.background != "" {
+		if plot.background == 0 {
+			plot.background = plot.nextTag()
+		}
+		cmd := fmt.Sprintf("set object %d %s", plot.background, template.background)
+		options = append([]setting{{key: "background", cmd: cmd}}, options...)
+	}
+	plot.mu.Unlock()
+	for _, option := range options {
+		err := plot.set(option.key, "%s", option.cmd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewPlot makes a new plot like glot.NewPlot and gives it the styling of the template.
+func (template *PlotTemplate) NewPlot(dimensions int, persist, debug bool) (*Plot, error) {
+	plot, err := NewPlot(dimensions, persist, debug)
+	if err != nil {
+		return nil, err
+	}
+	err = template.Apply(plot)
+	if err != nil {
+		plot.Close()
+		return nil, err
+	}
+	return plot, nil
+}
@@ -0,0 +1,49 @@
+package glot
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetTitle("Original")
+	plot.AddPointGroup("Sample1", "lines", []float64{1, 2, 3})
+	clone, err := plot.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clone.Close()
+	original, copied := plot.PointGroup["Sample1"], clone.PointGroup["Sample1"]
+	if copied.fname == original.fname || copied.plot != clone {
+		t.Error("Expected the clone to have its own data file")
+	}
+	if !strings.Contains(original.specs[0], quote(original.fname)) || !strings.Contains(copied.specs[0], quote(copied.fname)) {
+		t.Error("Expected each curve to plot its own data file, got ", original.specs, copied.specs)
+	}
+	content, _ := ioutil.ReadFile(copied.fname)
+	if string(content) != "0 1\n1 2\n2 3\n" {
+		t.Errorf("Unexpected data file content %q", content)
+	}
+	if clone.Settings().Title != "Original" {
+		t.Error("Expected the settings to be copied")
+	}
+}
+
+func TestPlotTemplate(t *testing.T) {
+	house, _ := NewPlot(2, false, false)
+	defer house.Close()
+	house.SetGrid()
+	house.SetTitle("House")
+	template := house.Template()
+	plot, err := template.NewPlot(2, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	if len(plot.options) != 1 || plot.options[0].cmd != "set grid" {
+		t.Error("Expected only the grid to be taken from the template, got ", plot.options)
+	}
+}
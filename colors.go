@@ -0,0 +1,28 @@
+package glot
+
+// Qualitative color cycles for SetColorCycle.
+var (
+	// ColorCycleTableau10 are the colors of Tableau 10, the color cycle of a new plot.
+	ColorCycleTableau10 = []string{"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f", "#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac"}
+	// ColorCycleSet1 are the colors of ColorBrewer's Set1.
+	ColorCycleSet1 = []string{"#e41a1c", "#377eb8", "#4daf4a", "#984ea3", "#ff7f00", "#ffff33", "#a65628", "#f781bf", "#999999"}
+	// ColorCycleDark2 are the colors of ColorBrewer's Dark2.
+	ColorCycleDark2 = []string{"#1b9e77", "#d95f02", "#7570b3", "#e7298a", "#66a61e", "#e6ab02", "#a6761d", "#666666"}
+	// ColorCycleOkabeIto are the colors of Okabe and Ito, which stay distinguishable for color blind readers.
+	ColorCycleOkabeIto = []string{"#000000", "#e69f00", "#56b4e9", "#009e73", "#f0e442", "#0072b2", "#d55e00", "#cc79a7"}
+)
+
+// SetColorCycle sets the colors given in turn to the PointGroups without a color
+// and draws the plot again. The n-th PointGroup of the plot gets the n-th color,
+// starting over after the last one, so the same series get the same colors every time.
+// An empty cycle leaves the colors to gnuplot.
+//
+// Usage
+//  plot.SetColorCycle(glot.ColorCycleDark2)
+//  plot.SetColorCycle([]string{"#003f5c", "#bc5090", "#ffa600"})
+func (plot *Plot) SetColorCycle(colors []string) error {
+	plot.mu.Lock()
+	plot.colors = append([]string{}, colors...)
+	plot.mu.Unlock()
+	return plot.redraw()
+}
@@ -0,0 +1,24 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestSetColorCycle(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.AddPointGroup("Sample1", "lines", []float64{1, 2, 3})
+	plot.AddPointGroup("Sample2", "lines", []float64{3, 2, 1})
+	if color := plot.PointGroup["Sample2"].lineColor(); color != ColorCycleTableau10[1] {
+		t.Error("Expected the second color of Tableau 10, got ", color)
+	}
+	plot.SetColorCycle([]string{"red", "blue"})
+	plot.PointGroup["Sample1"].SetColor("green")
+	if plot.PointGroup["Sample1"].lineColor() != "green" || plot.PointGroup["Sample2"].lineColor() != "blue" {
+		t.Error("Expected the explicit color and the second color of the cycle")
+	}
+	plot.AddPointsWithColor("Colored", []float64{1}, []float64{2}, []float64{3})
+	if color := plot.PointGroup["Colored"].lineColor(); color != "" {
+		t.Error("Expected the palette to color the points, got ", color)
+	}
+}
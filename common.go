@@ -1,7 +1,11 @@
 package glot
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"strings"
 )
 
 // SetTitle sets the title for the plot
@@ -13,8 +17,9 @@ import (
 //  plot, _ := glot.NewPlot(dimensions, persist, debug)
 //  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
 //  plot.SetTitle("Test Results")
-func (plot *Plot) SetTitle(title string) error {
-	return plot.Cmd(fmt.Sprintf("set title \"%s\" ", title))
+//  plot.SetTitle("Test Results", glot.LabelOptions{OffsetY: -1, Color: "#333333"})
+func (plot *Plot) SetTitle(title string, options ...LabelOptions) error {
+	return plot.setLabel("title", title, options)
 }
 
 // SetXLabel changes the label for the x-axis
@@ -27,8 +32,8 @@ func (plot *Plot) SetTitle(title string) error {
 //  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
 //  plot.SetTitle("Test Results")
 // 	plot.SetXLabel("X-Axis")
-func (plot *Plot) SetXLabel(label string) error {
-	return plot.Cmd(fmt.Sprintf("set xlabel '%s'", label))
+func (plot *Plot) SetXLabel(label string, options ...LabelOptions) error {
+	return plot.setLabel("xlabel", label, options)
 }
 
 // SetYLabel changes the label for the y-axis
@@ -41,8 +46,8 @@ func (plot *Plot) SetXLabel(label string) error {
 //  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
 //  plot.SetTitle("Test Results")
 // 	plot.SetYLabel("Y-Axis")
-func (plot *Plot) SetYLabel(label string) error {
-	return plot.Cmd(fmt.Sprintf("set ylabel '%s'", label))
+func (plot *Plot) SetYLabel(label string, options ...LabelOptions) error {
+	return plot.setLabel("ylabel", label, options)
 }
 
 // SetZLabel changes the label for the z-axis
@@ -55,8 +60,47 @@ func (plot *Plot) SetYLabel(label string) error {
 //  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
 //  plot.SetTitle("Test Results")
 // 	plot.SetZLabel("Z-Axis")
-func (plot *Plot) SetZLabel(label string) error {
-	return plot.Cmd(fmt.Sprintf("set zlabel '%s'", label))
+func (plot *Plot) SetZLabel(label string, options ...LabelOptions) error {
+	return plot.setLabel("zlabel", label, options)
+}
+
+// LabelOptions places and colors the title or the label of an axis, see SetTitle and SetXLabel.
+type LabelOptions struct {
+	OffsetX float64 // Shift to the right, in character widths
+	OffsetY float64 // Shift upwards, in character heights
+	Rotate  float64 // Rotation in degrees counter-clockwise, the default rotation when 0, ignored for the title
+	Color   string  // Color of the text
+}
+
+// labelOptions returns the options of a set title or set xlabel command.
+func labelOptions(key string, options []LabelOptions) string {
+	var cmd string
+	for _, option := range options {
+		if option.OffsetX != 0 || option.OffsetY != 0 {
+			cmd += fmt.Sprintf(" offset %v,%v", option.OffsetX, option.OffsetY)
+		}
+		if option.Rotate != 0 && key != "title" {
+			cmd += fmt.Sprintf(" rotate by %v", option.Rotate)
+		}
+		if option.Color != "" {
+			cmd += fmt.Sprintf(" textcolor rgb %s", quote(option.Color))
+		}
+	}
+	return cmd
+}
+
+// SetXTicsRotate rotates the tic labels of the x-axis by the angle in degrees counter-clockwise,
+// so that long categorical labels don't overlap. The labels are right aligned to end at their tic.
+// An angle of 0 draws them horizontally again.
+//
+// Usage
+//  plot.SetXTicLabels(glot.Tic{Position: 1, Label: "January"}, glot.Tic{Position: 2, Label: "February"})
+//  plot.SetXTicsRotate(45)
+func (plot *Plot) SetXTicsRotate(angle float64) error {
+	if angle == 0 {
+		return plot.set("xtics rotate", "set xtics norotate")
+	}
+	return plot.set("xtics rotate", "set xtics rotate by %v right", angle)
 }
 
 // SetLabels Functions helps to set labels for x, y, z axis  simultaneously
@@ -72,7 +116,7 @@ func (plot *Plot) SetZLabel(label string) error {
 func (plot *Plot) SetLabels(labels ...string) error {
 	ndims := len(labels)
 	if ndims > 3 || ndims <= 0 {
-		return &gnuplotError{fmt.Sprintf("invalid number of dims '%v'", ndims)}
+		return &gnuplotError{err: fmt.Sprintf("invalid number of dims '%v'", ndims), kind: ErrInvalidDimensions}
 	}
 	var err error
 
@@ -112,7 +156,7 @@ func (plot *Plot) SetLabels(labels ...string) error {
 //  plot.SetTitle("Test Results")
 // 	plot.SetXrange(-2,2)
 func (plot *Plot) SetXrange(start int, end int) error {
-	return plot.Cmd(fmt.Sprintf("set xrange [%d:%d]", start, end))
+	return plot.setRange("x", &Range{float64(start), float64(end)}, "set xrange [%d:%d]", start, end)
 }
 
 // SetLogscale changes the label for the x-axis
@@ -126,7 +170,7 @@ func (plot *Plot) SetXrange(start int, end int) error {
 //  plot.AddPointGroup("rates", "circle", [][]float64{{2, 4, 8, 16, 32}, {4, 7, 4, 10, 3}})
 //  plot.SetLogscale("x", 2)
 func (plot *Plot) SetLogscale(axis string, base int) error {
-	return plot.Cmd(fmt.Sprintf("set logscale %s %d", axis, base))
+	return plot.set("logscale "+axis, "set logscale %s %d", axis, base)
 }
 
 // SetYrange changes the label for the y-axis
@@ -140,7 +184,7 @@ func (plot *Plot) SetLogscale(axis string, base int) error {
 //  plot.SetTitle("Test Results")
 // 	plot.SetYrange(-2,2)
 func (plot *Plot) SetYrange(start int, end int) error {
-	return plot.Cmd(fmt.Sprintf("set yrange [%d:%d]", start, end))
+	return plot.setRange("y", &Range{float64(start), float64(end)}, "set yrange [%d:%d]", start, end)
 }
 
 // SetZrange changes the label for the z-axis
@@ -154,7 +198,84 @@ func (plot *Plot) SetYrange(start int, end int) error {
 //  plot.SetTitle("Test Results")
 // 	plot.SetZrange(-2,2)
 func (plot *Plot) SetZrange(start int, end int) error {
-	return plot.Cmd(fmt.Sprintf("set zrange [%d:%d]", start, end))
+	return plot.setRange("z", &Range{float64(start), float64(end)}, "set zrange [%d:%d]", start, end)
+}
+
+// SetXRange changes the range of the x-axis.
+// Unlike SetXrange the bounds don't have to be integers.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  plot.SetXRange(-0.5, 3.5)
+func (plot *Plot) SetXRange(min, max float64) error {
+	return plot.setRange("x", &Range{min, max}, "set xrange [%v:%v]", min, max)
+}
+
+// SetYRange changes the range of the y-axis.
+// Unlike SetYrange the bounds don't have to be integers.
+func (plot *Plot) SetYRange(min, max float64) error {
+	return plot.setRange("y", &Range{min, max}, "set yrange [%v:%v]", min, max)
+}
+
+// SetZRange changes the range of the z-axis.
+// Unlike SetZrange the bounds don't have to be integers.
+func (plot *Plot) SetZRange(min, max float64) error {
+	return plot.setRange("z", &Range{min, max}, "set zrange [%v:%v]", min, max)
+}
+
+// SetLogScale makes the axes logarithmic with the given base.
+// The axis is any combination of x, y, z, x2, y2 and cb, like "xy".
+// Unlike SetLogscale the base doesn't have to be an integer.
+//
+// Usage
+//  plot.SetLogScale("y", 10)
+func (plot *Plot) SetLogScale(axis string, base float64) error {
+	return plot.set("logscale "+axis, "set logscale %s %v", axis, base)
+}
+
+// Tic is a labeled tic mark on an axis.
+type Tic struct {
+	Position float64
+	Label    string
+}
+
+// SetXTics puts a tic mark on the x-axis every interval.
+//
+// Usage
+//  plot.SetXTics(0.5)
+func (plot *Plot) SetXTics(interval float64) error {
+	return plot.set("xtics", "set xtics %v", interval)
+}
+
+// SetYTics puts a tic mark on the y-axis every interval.
+func (plot *Plot) SetYTics(interval float64) error {
+	return plot.set("ytics", "set ytics %v", interval)
+}
+
+// SetXTicLabels replaces the tic marks of the x-axis by the given labeled tics.
+//
+// Usage
+//  plot.SetXTicLabels(glot.Tic{Position: 1, Label: "Mon"}, glot.Tic{Position: 2, Label: "Tue"})
+func (plot *Plot) SetXTicLabels(tics ...Tic) error {
+	return plot.set("xtics", "set xtics (%s)", ticList(tics))
+}
+
+// SetYTicLabels replaces the tic marks of the y-axis by the given labeled tics.
+func (plot *Plot) SetYTicLabels(tics ...Tic) error {
+	return plot.set("ytics", "set ytics (%s)", ticList(tics))
+}
+
+// ticList formats tics as the list of a set xtics command.
+func ticList(tics []Tic) string {
+	list := make([]string, len(tics))
+	for i, tic := range tics {
+		list[i] = fmt.Sprintf("%s %v", quote(tic.Label), tic.Position)
+	}
+	return strings.Join(list, ", ")
 }
 
 // SavePlot function is used to save the plot at this point.
@@ -171,15 +292,61 @@ func (plot *Plot) SetZrange(start int, end int) error {
 // 	plot.SetZrange(-2,2)
 //  plot.SavePlot("1.jpeg")
 func (plot *Plot) SavePlot(filename string) (err error) {
-	if plot.nplots == 0 {
-		return &gnuplotError{fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	if plot.empty() {
+		return &gnuplotError{err: fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	}
+	outputFormat := plot.terminalCommand()
+	if drawn, err := plot.drawInsets(plot.ctx, outputFormat, "set output "+quote(filename), nil); drawn {
+		return err
 	}
-	outputFormat := "set terminal " + plot.format
 	plot.CheckedCmd(outputFormat)
-	outputFileCommand := "set output" + "'" + filename + "'"
+	outputFileCommand := "set output " + quote(filename)
 	plot.CheckedCmd(outputFileCommand)
 	plot.CheckedCmd("replot  ")
-	return nil
+	plot.restoreTerminal()
+	return plot.Flush()
+}
+
+// SavePlotContext is like SavePlot but returns the error of the context
+// when it is cancelled or times out before gnuplot accepted all commands.
+//
+// Usage
+//  ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//  defer cancel()
+//  err := plot.SavePlotContext(ctx, "1.png")
+func (plot *Plot) SavePlotContext(ctx context.Context, filename string) (err error) {
+	if plot.empty() {
+		return &gnuplotError{err: fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	}
+	if drawn, err := plot.drawInsets(ctx, plot.terminalCommand(), "set output "+quote(filename), nil); drawn {
+		return err
+	}
+	err = plot.CmdContext(ctx, "%s", plot.terminalCommand())
+	if err != nil {
+		return err
+	}
+	err = plot.CmdContext(ctx, "set output %s", quote(filename))
+	if err != nil {
+		return err
+	}
+	err = plot.CmdContext(ctx, "replot")
+	if err != nil {
+		return err
+	}
+	err = plot.Flush()
+	if err != nil {
+		return err
+	}
+	// gnuplot may still be drawing, wait until it finished the replot.
+	if ctx.Done() != nil && plot.proc != nil && plot.proc.errlog != nil {
+		err = plot.proc.errlog.wait(ctx)
+		if err != nil {
+			plot.proc.kill()
+			return err
+		}
+	}
+	plot.restoreTerminal()
+	return plot.Flush()
 }
 
 // SavePlotWithSize function is used to save the plot at this point.
@@ -196,15 +363,90 @@ func (plot *Plot) SavePlot(filename string) (err error) {
 // 	plot.SetZrange(-2,2)
 //  plot.SavePlotWithSize("1.jpeg", 1024, 900)
 func (plot *Plot) SavePlotWithSize(filename string, width, height int) (err error) {
-	if plot.nplots == 0 {
-		return &gnuplotError{fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	if plot.empty() {
+		return &gnuplotError{err: fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	}
+	plot.mu.Lock()
+	format, options := plot.format, plot.terminal
+	plot.mu.Unlock()
+	options.Width, options.Height = float64(width), float64(height)
+	outputFormat := terminalCommand(format, options)
+	if drawn, err := plot.drawInsets(plot.ctx, outputFormat, "set output "+quote(filename), nil); drawn {
+		return err
 	}
-	outputFormat := fmt.Sprintf("set terminal %s size %d,%d", plot.format, width, height)
 	plot.CheckedCmd(outputFormat)
-	outputFileCommand := "set output" + "'" + filename + "'"
+	outputFileCommand := "set output " + quote(filename)
 	plot.CheckedCmd(outputFileCommand)
 	plot.CheckedCmd("replot  ")
-	return nil
+	plot.restoreTerminal()
+	return plot.Flush()
+}
+
+// Render draws the plot in the given format and writes the image to w.
+// Unlike SavePlot no output file is created, which makes it possible to
+// serve plots straight from an HTTP handler.
+//
+// Usage
+//  func handler(w http.ResponseWriter, r *http.Request) {
+//  	w.Header().Set("Content-Type", "image/svg+xml")
+//  	plot.Render(w, "svg")
+//  }
+func (plot *Plot) Render(w io.Writer, format string) error {
+	plot.mu.Lock()
+	options := plot.terminal
+	plot.mu.Unlock()
+	return plot.RenderWithOptions(w, format, options)
+}
+
+// RenderWithOptions is like Render but draws the plot with the given terminal options
+// instead of the ones set by SetTerminalOptions.
+//
+// Usage
+//  plot.RenderWithOptions(w, "pngcairo", glot.TerminalOptions{Width: 320, Height: 240})
+func (plot *Plot) RenderWithOptions(w io.Writer, format string, options TerminalOptions) error {
+	if plot.empty() {
+		return &gnuplotError{err: fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	}
+	if !isAllowedFormat(format) {
+		return &gnuplotError{err: fmt.Sprintf("invalid format '%s'", format)}
+	}
+	return plot.renderWith(w, terminalCommand(format, options))
+}
+
+// renderWith draws the plot with a gnuplot process of its own using the terminal command
+// and writes the output to w.
+func (plot *Plot) renderWith(w io.Writer, terminal string) error {
+	ctx := plot.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if drawn, err := plot.drawInsets(ctx, terminal, "set output", w); drawn {
+		return err
+	}
+	commands := []string{terminal, "set output"}
+	commands = append(commands, plot.settings()...)
+	commands = append(commands, plot.plotAll())
+	return plot.runScript(ctx, commands, w)
+}
+
+// RenderBytes is like Render but returns the image.
+func (plot *Plot) RenderBytes(format string) ([]byte, error) {
+	var buf bytes.Buffer
+	err := plot.Render(&buf, format)
+	return buf.Bytes(), err
+}
+
+// allowedFormats are the formats plots can be saved in.
+var allowedFormats = []string{"png", "pdf", "svg", "eps", "pdfcairo", "pngcairo",
+	"cairolatex", "tikz", "canvas", "webp"}
+
+func isAllowedFormat(format string) bool {
+	for _, s := range allowedFormats {
+		if format == s {
+			return true
+		}
+	}
+	return false
 }
 
 // SetFormat function is used to save the plot at this point.
@@ -220,18 +462,24 @@ func (plot *Plot) SavePlotWithSize(filename string, width, height int) (err erro
 //  plot.SetTitle("Test Results")
 // 	plot.SetFormat("pdf")
 //  plot.SavePlot("1.pdf")
+// Supported formats are png, pdf, svg, eps, pdfcairo, pngcairo, cairolatex, tikz, canvas and webp,
+// see SetTerminalOptions for their size and font.
 // NOTE: png is default format for saving files.
 func (plot *Plot) SetFormat(newformat string) error {
-	allowed := []string{"png", "pdf", "svg"}
+	allowed := allowedFormats
 	for _, s := range allowed {
 		if newformat == s {
+			if err := plot.requireFeature(newformat); err != nil {
+				return err
+			}
+			plot.mu.Lock()
 			plot.format = newformat
+			plot.mu.Unlock()
 			return nil
 		}
 	}
-	fmt.Printf("** Format '%v' not in allowed list %v\n", newformat, allowed)
-	fmt.Printf("** default to 'png'\n")
-	err := &gnuplotError{fmt.Sprintf("invalid format '%s'", newformat)}
+	plot.log(LogWarn, "format not allowed", "format", newformat, "allowed", allowed)
+	err := &gnuplotError{err: fmt.Sprintf("invalid format '%s'", newformat)}
 	return err
 }
 
@@ -241,16 +489,71 @@ func (plot *Plot) SetBoxWidth(width float64, absolute bool) error {
 	if absolute {
 		str = "absolute"
 	}
-	return plot.Cmd(fmt.Sprintf("set boxwidth %f %s", width, str))
+	return plot.set("boxwidth", "set boxwidth %f %s", width, str)
 }
 
 // SetPlotScale change the scale of width/height of image
 // set size {{no}square | ratio <r> | noratio} {<xscale>,<yscale>}
 func (plot *Plot) SetPlotScale(xScale float64, yScale float64) error {
-	return plot.Cmd(fmt.Sprintf("set size %f,%f", xScale, yScale))
+	return plot.set("size", "set size %f,%f", xScale, yScale)
 }
 
-// SetGrid ...
-func (plot *Plot) SetGrid() error {
-	return plot.Cmd("set grid")
+// SetGrid turns the grid on, or off when called with false.
+//
+// Usage
+//  plot.SetGrid()
+//  plot.SetGrid(false)
+func (plot *Plot) SetGrid(on ...bool) error {
+	if len(on) > 0 && !on[0] {
+		return plot.set("grid", "unset grid")
+	}
+	return plot.set("grid", "set grid")
+}
+
+// LegendOptions configures the legend (key) of the plot, see SetLegend.
+type LegendOptions struct {
+	Hide     bool   // Don't draw the legend at all
+	Position string // Any of left, right or center with top, bottom or center, like "top left"
+	Outside  bool   // Draw the legend outside of the graph
+	Box      bool   // Draw a box around the legend
+	Font     string // Font of the entries, like "Helvetica,10"
+	Columns  int    // Lay the entries out horizontally in at most this many columns
+	Reverse  bool   // Put the sample to the left of the title of every entry
+}
+
+// SetLegend configures the position and layout of the legend.
+//
+// Usage
+//  plot.SetLegend(glot.LegendOptions{Position: "bottom right", Box: true})
+//  plot.SetLegend(glot.LegendOptions{Hide: true})
+func (plot *Plot) SetLegend(options LegendOptions) error {
+	if options.Hide {
+		return plot.set("key", "unset key")
+	}
+	cmd := "set key"
+	if options.Outside {
+		cmd += " outside"
+	} else {
+		cmd += " inside"
+	}
+	if options.Position != "" {
+		cmd += " " + options.Position
+	}
+	if options.Box {
+		cmd += " box"
+	} else {
+		cmd += " nobox"
+	}
+	if options.Font != "" {
+		cmd += " font " + quote(options.Font)
+	}
+	if options.Columns > 0 {
+		cmd += fmt.Sprintf(" horizontal maxcols %d", options.Columns)
+	}
+	if options.Reverse {
+		cmd += " reverse"
+	} else {
+		cmd += " noreverse"
+	}
+	return plot.set("key", "%s", cmd)
 }
@@ -1,6 +1,16 @@
 package glot
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
 
 func TestSetLabels(t *testing.T) {
 	dimensions := 3
@@ -23,3 +33,62 @@ func TestSetFormat(t *testing.T) {
 		t.Error("SetLabels raises error when non-supported format is passed as an argument.")
 	}
 }
+
+func TestRender(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	var buf bytes.Buffer
+	err := plot.Render(&buf, "png")
+	if err == nil {
+		t.Error("Render raises error when the plot has no curves.")
+	}
+}
+
+func TestSetLabelOptions(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetYLabel("Latency", LabelOptions{OffsetX: 1, Rotate: 90, Color: "red"})
+	plot.SetTitle("Results", LabelOptions{Rotate: 45})
+	plot.SetXTicsRotate(45)
+	expected := []string{`set ylabel "Latency" offset 1,0 rotate by 90 textcolor rgb "red"`, `set title "Results"`, "set xtics rotate by 45 right"}
+	for i, cmd := range expected {
+		if plot.options[i].cmd != cmd {
+			t.Errorf("Expected %q, got %q", cmd, plot.options[i].cmd)
+		}
+	}
+}
+
+func TestSavePlotContextWaitsForGnuplot(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a shell script as gnuplot")
+	}
+	dir, err := ioutil.TempDir("", "glot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// A gnuplot that hangs and never finishes a command.
+	gnuplot := filepath.Join(dir, "gnuplot")
+	err = ioutil.WriteFile(gnuplot, []byte("#!/bin/sh\nexec sleep 60\n"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plot, err := NewPlotWithOptions(WithGnuplotPath(gnuplot))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = plot.SavePlotContext(ctx, filepath.Join(dir, "plot.png"))
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected the deadline to be exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("SavePlotContext returned after %v", elapsed)
+	}
+	if atomic.LoadInt32(&plot.proc.killed) == 0 {
+		t.Error("Expected the hanging gnuplot to be killed")
+	}
+}
@@ -1,10 +1,17 @@
 package glot
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
 )
 
 var gGnuplotCmd string
@@ -20,43 +27,203 @@ func min(a, b int) int {
 	return b
 }
 
+// gnuplotEnv is the environment variable overriding the gnuplot binary.
+const gnuplotEnv = "GLOT_GNUPLOT"
+
 // Function to intialize the package and check for GNU plot installation
-// This raises an error if GNU plot is not installed
+// When GNU plot is not installed NewPlot returns ErrGnuplotNotFound.
+// The binary can be chosen with the GLOT_GNUPLOT environment variable.
 func init() {
-	var err error
-	gGnuplotCmd, err = exec.LookPath("gnuplot")
+	if path := os.Getenv(gnuplotEnv); path != "" {
+		gGnuplotCmd, _ = exec.LookPath(path)
+		return
+	}
+	gGnuplotCmd = findGnuplot()
+}
+
+// findGnuplot returns the path of gnuplot on the PATH, or on Windows, where the installer
+// doesn't add gnuplot to the PATH by default, in its usual install directories.
+func findGnuplot() string {
+	if path, err := exec.LookPath("gnuplot"); err == nil {
+		return path
+	}
+	if runtime.GOOS != "windows" {
+		return ""
+	}
+	for _, dir := range []string{os.Getenv("ProgramFiles"), os.Getenv("ProgramW6432"), os.Getenv("ProgramFiles(x86)"),
+		`C:\Program Files`, `C:\Program Files (x86)`} {
+		if dir == "" {
+			continue
+		}
+		path := filepath.Join(dir, "gnuplot", "bin", "gnuplot.exe")
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// SetGnuplotPath sets the gnuplot binary used by the plots created afterwards,
+// for applications that ship their own gnuplot. It should be called at startup.
+//
+// Usage
+//  if err := glot.SetGnuplotPath("/opt/myapp/bin/gnuplot"); err != nil {
+//  	log.Fatal(err)
+//  }
+func SetGnuplotPath(path string) error {
+	cmd, err := exec.LookPath(path)
 	if err != nil {
-		fmt.Printf("** could not find path to 'gnuplot':\n%v\n", err)
-		panic("could not find 'gnuplot'")
+		return &gnuplotError{err: fmt.Sprintf("could not use %q as gnuplot: %v", path, err), kind: ErrGnuplotNotFound}
 	}
+	gGnuplotCmd = cmd
+	return nil
 }
 
+// Available reports whether gnuplot can be run and returns its version,
+// so that applications can check for it at startup.
+//
+// Usage
+//  version, err := glot.Available()
+//  if err != nil {
+//  	log.Fatal("gnuplot is required: ", err)
+//  }
+//  log.Println("using", version)
+func Available() (version string, err error) {
+	if gGnuplotCmd == "" {
+		return "", &gnuplotError{err: "could not find path to 'gnuplot'", kind: ErrGnuplotNotFound}
+	}
+	out, err := exec.Command(gGnuplotCmd, "--version").Output()
+	if err != nil {
+		return "", &gnuplotError{err: fmt.Sprintf("could not run %s: %v", gGnuplotCmd, err), kind: ErrGnuplotNotFound}
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Errors returned by glot for the failures callers may want to handle.
+// The returned errors carry a descriptive message and wrap one of these, so use
+// errors.Is(err, glot.ErrInvalidDimensions) to test for them.
+var (
+	ErrGnuplotNotFound     = errors.New("glot: could not find gnuplot")
+	ErrInvalidDimensions   = errors.New("glot: invalid dimensions")
+	ErrDuplicatePointGroup = errors.New("glot: duplicate PointGroup")
+	ErrUnsupportedDataType = errors.New("glot: unsupported data type")
+	ErrUnknownTerminal     = errors.New("glot: unknown terminal")
+	ErrLengthMismatch      = errors.New("glot: data length mismatch")
+	ErrUnknownStyle        = errors.New("glot: unknown style")
+	ErrFFmpegNotFound      = errors.New("glot: could not find ffmpeg")
+	ErrUnsupportedFeature  = errors.New("glot: feature not supported by gnuplot")
+	ErrEmptyData           = errors.New("glot: no data")
+	ErrInvalidIndex        = errors.New("glot: index out of range")
+)
+
 type gnuplotError struct {
-	err string
+	err  string
+	kind error // one of the exported Err values, or nil
 }
 
 func (e *gnuplotError) Error() string {
 	return e.err
 }
 
+// Unwrap returns the exported error value that classifies this error.
+func (e *gnuplotError) Unwrap() error {
+	return e.kind
+}
+
 // plotterProcess is the type for handling gnu commands.
 type plotterProcess struct {
 	handle *exec.Cmd
 	stdin  io.WriteCloser
+	errlog *commandLog // Messages gnuplot printed on its standard error
+	killed int32       // Set atomically once the process is stopped on purpose
 }
 
-// newPlotterProc function makes the plotterProcess struct
-func newPlotterProc(persist bool) (*plotterProcess, error) {
+// newPlotterProc function makes the plotterProcess struct running the gnuplot binary.
+// The process is killed when the context is done.
+func newPlotterProc(ctx context.Context, gnuplot string, persist bool, args ...string) (*plotterProcess, error) {
+	if gnuplot == "" {
+		return nil, &gnuplotError{err: "could not find path to 'gnuplot'", kind: ErrGnuplotNotFound}
+	}
 	procArgs := []string{}
 	if persist {
 		procArgs = append(procArgs, "-persist")
 	}
-	cmd := exec.Command(gGnuplotCmd, procArgs...)
+	procArgs = append(procArgs, args...)
+	cmd := exec.CommandContext(ctx, gnuplot, procArgs...)
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
 		return nil, err
 	}
-	return &plotterProcess{handle: cmd, stdin: stdin}, cmd.Start()
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	proc := &plotterProcess{handle: cmd, stdin: stdin, errlog: newCommandLog()}
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	go proc.errlog.read(stderr)
+	return proc, nil
+}
+
+// runScript runs the commands in a new gnuplot process and waits for it to exit.
+// The standard output of gnuplot is written to stdout unless it is nil.
+// The args are passed to gnuplot. When gnuplot fails the error holds what it printed.
+func runScript(ctx context.Context, commands []string, stdout io.Writer, logger Logger, args ...string) error {
+	if gGnuplotCmd == "" {
+		return &gnuplotError{err: "could not find path to 'gnuplot'", kind: ErrGnuplotNotFound}
+	}
+	cmd := exec.CommandContext(ctx, gGnuplotCmd, args...)
+	cmd.Stdout = stdout
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+	if logger != nil {
+		logger.Log(LogInfo, "gnuplot started", "pid", cmd.Process.Pid, "commands", len(commands))
+	}
+	for _, command := range commands {
+		if logger != nil {
+			logger.Log(LogDebug, "command", "cmd", command)
+		}
+		_, err = io.WriteString(stdin, command+"\n")
+		if err != nil {
+			stdin.Close()
+			cmd.Process.Kill()
+			cmd.Wait()
+			return err
+		}
+	}
+	stdin.Close()
+	err = cmd.Wait()
+	if err != nil && stderr.Len() > 0 {
+		return &gnuplotError{err: fmt.Sprintf("gnuplot failed: %v: %s", err, strings.TrimSpace(stderr.String()))}
+	}
+	return err
+}
+
+// wait closes the input of the gnuplot process and waits for it to exit.
+func (proc *plotterProcess) wait() error {
+	atomic.StoreInt32(&proc.killed, 1)
+	proc.stdin.Close()
+	<-proc.errlog.done
+	return proc.handle.Wait()
+}
+
+// kill stops the gnuplot process without waiting for pending commands.
+func (proc *plotterProcess) kill() {
+	atomic.StoreInt32(&proc.killed, 1)
+	proc.stdin.Close()
+	if proc.handle.Process != nil {
+		proc.handle.Process.Kill()
+	}
 }
 
 // Cmd sends a command to the gnuplot subprocess and returns an error
@@ -68,17 +235,89 @@ func newPlotterProc(persist bool) (*plotterProcess, error) {
 //     panic(err)
 //   }
 func (plot *Plot) Cmd(format string, a ...interface{}) error {
-	cmd := fmt.Sprintf(format, a...) + "\n"
-	n, err := io.WriteString(plot.proc.stdin, cmd)
-	if plot.debug {
-		//buf := new(bytes.Buffer)
-		//io.Copy(buf, plot.proc.handle.Stdout)
-		fmt.Printf("cmd> %v", cmd)
-		fmt.Printf("res> %v\n", n)
+	ctx := plot.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return plot.CmdContext(ctx, format, a...)
+}
+
+// CmdContext is like Cmd but gives up when the context is done.
+// A command that is still blocked on the gnuplot process at that point
+// kills the process, since gnuplot can't be interrupted half way through a command.
+func (plot *Plot) CmdContext(ctx context.Context, format string, a ...interface{}) error {
+	if ctx.Done() == nil {
+		return plot.writeCmd(format, a...)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- plot.writeCmd(format, a...)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if plot.proc != nil {
+			plot.proc.kill()
+		}
+		return ctx.Err()
+	}
+}
+
+func (plot *Plot) writeCmd(format string, a ...interface{}) error {
+	// gnuplot outside of Windows doesn't accept the CRLF line ends of scripts written on Windows.
+	cmd := strings.Replace(fmt.Sprintf(format, a...), "\r\n", "\n", -1) + "\n"
+	plot.cmdMu.Lock()
+	defer plot.cmdMu.Unlock()
+	replayed := plot.record(cmd[:len(cmd)-1])
+	plot.log(LogDebug, "command", "cmd", cmd[:len(cmd)-1])
+	if plot.dryRun {
+		return nil
+	}
+	if plot.scriptMode {
+		plot.pending.WriteString(cmd)
+		return nil
+	}
+	if plot.plotter != nil {
+		return plot.plotter.SendCommand(cmd[:len(cmd)-1])
+	}
+	_, err := plot.proc.write(cmd)
+	if err != nil && plot.canRestart() {
+		err = plot.restart(replayed)
+		if err == nil {
+			_, err = plot.proc.write(cmd)
+		}
 	}
 	return err
 }
 
+// record adds the command to the history and returns the number of commands before it
+// that a restart replays. A datablock defined again replaces its earlier definition,
+// so that appending to it doesn't grow the history by the whole block every time,
+// and a replot right after another one isn't kept, so that live plots don't grow it either.
+// The commands to gnuplot must be serialized by the caller.
+func (plot *Plot) record(cmd string) int {
+	if cmd == "replot" && len(plot.history) > 0 && plot.history[len(plot.history)-1] == "replot" {
+		return len(plot.history)
+	}
+	if strings.HasPrefix(cmd, "$") {
+		head := strings.SplitN(cmd, "\n", 2)[0]
+		if strings.HasSuffix(head, " << EOD") {
+			for i, previous := range plot.history {
+				if strings.HasPrefix(previous, head+"\n") {
+					plot.history[i] = cmd
+					return len(plot.history)
+				}
+			}
+		}
+	}
+	plot.history = append(plot.history, cmd)
+	return len(plot.history) - 1
+}
+
 // CheckedCmd is a convenience wrapper around Cmd: it will panic if the
 // error returned by Cmd isn't nil.
 // ex:
@@ -95,33 +334,111 @@ func (plot *Plot) CheckedCmd(format string, a ...interface{}) {
 // A map between os files and file names
 type tmpfilesDb map[string]*os.File
 
+// removeTmpfiles deletes the temporary data files.
+func removeTmpfiles(tmpfiles tmpfilesDb) {
+	for fname := range tmpfiles {
+		os.Remove(fname)
+		delete(tmpfiles, fname)
+	}
+}
+
+// setFinalizer stops the gnuplot process and removes the temporary files of a plot
+// that is garbage collected without being closed. The finalizer is set on the process
+// since the plot itself is part of a cycle with its PointGroups.
+// It is called for every new process of a plot, whose errors go to the logger of the plot.
+func (plot *Plot) setFinalizer() {
+	if plot.proc.errlog != nil {
+		plot.proc.errlog.setLogger(plot.getLogger())
+	}
+	if plot.proc.handle != nil && plot.proc.handle.Process != nil {
+		plot.log(LogInfo, "gnuplot process attached", "pid", plot.proc.handle.Process.Pid, "path", plot.proc.handle.Path)
+	}
+	tmpfiles := plot.tmpfiles
+	runtime.SetFinalizer(plot.proc, func(proc *plotterProcess) {
+		proc.wait()
+		removeTmpfiles(tmpfiles)
+	})
+}
+
 // Close makes sure all resources used by the gnuplot subprocess are reclaimed.
+// It stops the gnuplot process, waits for it to exit and removes the temporary data files.
 // This method is typically called when the Plotter instance is not needed
 // anymore. That's usually done via a defer statement:
 //   p, err := gnuplot.NewPlotter(...)
 //   if err != nil { /* handle error */ }
 //   defer p.Close()
+// Calling Close more than once does nothing.
 func (plot *Plot) Close() (err error) {
-	if plot.proc != nil && plot.proc.handle != nil {
-		plot.proc.stdin.Close()
-		err = plot.proc.handle.Wait()
+	plot.mu.Lock()
+	closed := plot.closed
+	plot.closed = true
+	plot.mu.Unlock()
+	if closed {
+		return nil
 	}
-	plot.ResetPlot()
+	plot.StopRefresh()
+	if plot.proc != nil {
+		runtime.SetFinalizer(plot.proc, nil)
+	}
+	if plot.plotter != nil {
+		err = plot.plotter.Close()
+	} else if plot.pool != nil {
+		// The process lives on, so let it finish reading the data files first.
+		plot.proc.errlog.catchUp()
+		err = plot.pool.release(plot.proc)
+	} else if plot.proc != nil && plot.proc.handle != nil {
+		err = plot.proc.wait()
+		plot.log(LogInfo, "gnuplot stopped", "pid", plot.proc.handle.Process.Pid, "err", err)
+	}
+	plot.mu.Lock()
+	insets := plot.insets
+	plot.mu.Unlock()
+	for _, inset := range insets {
+		inset.plot.Close()
+	}
+	plot.mu.Lock()
+	for fname := range plot.tmpfiles {
+		plot.log(LogDebug, "data file removed", "file", fname)
+	}
+	removeTmpfiles(plot.tmpfiles)
+	plot.clear()
+	plot.mu.Unlock()
 	return err
 }
 
+// empty reports whether no PointGroup is plotted.
+func (plot *Plot) empty() bool {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	return plot.nplots == 0
+}
+
+// cleanplot forgets the plotted PointGroups. Their data files are kept
+// until the plot is closed, since gnuplot may still be reading them.
 func (plot *Plot) cleanplot() (err error) {
-	plot.tmpfiles = make(tmpfilesDb)
 	plot.nplots = 0
 	return err
 }
 
+// clear removes all PointGroups.
+func (plot *Plot) clear() {
+	plot.cleanplot()
+	plot.PointGroup = make(map[string]*PointGroup) // Adding a mapping between a curve name and a curve
+}
+
 // ResetPlot is used to reset the whole plot.
 // This removes all the PointGroup's from the plot and makes it new.
+// gnuplot is reset as well, after which the settings of the plot are applied again,
+// see Settings. Settings made directly with Cmd are lost.
 // Usage
 //  plot.ResetPlot()
 func (plot *Plot) ResetPlot() (err error) {
-	plot.cleanplot()
-	plot.PointGroup = make(map[string]*PointGroup) // Adding a mapping between a curve name and a curve
-	return err
+	plot.mu.Lock()
+	plot.clear()
+	plot.mu.Unlock()
+	err = plot.Cmd("reset")
+	if err != nil {
+		return err
+	}
+	return plot.applySettings()
 }
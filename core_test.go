@@ -1,6 +1,12 @@
 package glot
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+)
 
 func TestMin(t *testing.T) {
 	var v int
@@ -9,3 +15,55 @@ func TestMin(t *testing.T) {
 		t.Error("Expected 1, got ", v)
 	}
 }
+
+func TestSetGnuplotPath(t *testing.T) {
+	cmd := gGnuplotCmd
+	err := SetGnuplotPath("/nonexistent/gnuplot")
+	if !errors.Is(err, ErrGnuplotNotFound) || gGnuplotCmd != cmd {
+		t.Error("Expected ErrGnuplotNotFound and the path unchanged, got ", err)
+	}
+	if _, err := Available(); err != nil {
+		t.Error("Expected gnuplot to be available, got ", err)
+	}
+}
+
+func TestErrDuplicatePointGroup(t *testing.T) {
+	plot, _ := NewPlot(1, false, false)
+	plot.AddPointGroup("Sample1", "points", []int32{51, 8, 4, 11})
+	err := plot.AddPointGroup("Sample1", "points", []int32{1, 2, 4, 11})
+	if !errors.Is(err, ErrDuplicatePointGroup) {
+		t.Error("Expected ErrDuplicatePointGroup, got ", err)
+	}
+}
+
+func TestConcurrentAddPointGroup(t *testing.T) {
+	plot, _ := NewPlot(1, false, false)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			plot.AddPointGroup(fmt.Sprintf("Sample%d", i), "points", []float64{1, 2, 3})
+		}(i)
+	}
+	wg.Wait()
+	if len(plot.PointGroup) != 8 {
+		t.Error("Expected 8 PointGroups, got ", len(plot.PointGroup))
+	}
+}
+
+func TestCloseRemovesTmpfiles(t *testing.T) {
+	plot, _ := NewPlot(1, false, false)
+	plot.AddPointGroup("Sample1", "points", []float64{1, 2, 4, 11})
+	fname := plot.PointGroup["Sample1"].fname
+	plot.ResetPlot()
+	if err := plot.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(fname); !os.IsNotExist(err) {
+		t.Error("Expected the data file to be removed, got ", err)
+	}
+	if err := plot.Close(); err != nil {
+		t.Error("Expected a second Close to do nothing, got ", err)
+	}
+}
@@ -0,0 +1,142 @@
+package glot
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// CSVHeader tells AddFromCSVAdvance whether the first row of a CSV file is a header.
+type CSVHeader int
+
+// The ways the header of a CSV file is found.
+const (
+	HeaderDetect   CSVHeader = iota // The first row is a header when it has a field that is not a number
+	HeaderFirstRow                  // The first row is always a header, also when its names are numbers
+	HeaderNone                      // There is no header, the first row is data
+)
+
+// CSVOptions controls how AddFromCSVAdvance reads a CSV file.
+type CSVOptions struct {
+	Delimiter rune      // Separator of the fields, ',' when 0
+	Style     string    // Style of the created PointGroups, "points" when empty
+	Header    CSVHeader // Whether the first row is a header, detected when HeaderDetect
+}
+
+// AddFromCSV adds a PointGroup for every y column of a CSV file, plotted against the x column.
+// Columns are selected by their name in the header or by their index, counted from 0.
+// The first row is taken as header when it contains a field that is not a number,
+// and the header names the PointGroups. CSVOptions.Header overrides the detection,
+// for headers of numbers like years and for data rows with text. When xCol is empty the y columns are
+// plotted against their row number on a 1-d plot.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddFromCSV("measurements.csv", "time", "temperature", "humidity")
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddFromCSV(path string, xCol string, yCols ...string) error {
+	return plot.AddFromCSVAdvance(path, CSVOptions{}, xCol, yCols...)
+}
+
+// AddFromCSVAdvance is AddFromCSV with control over the delimiter and style.
+//
+// Usage
+//  plot.AddFromCSVAdvance("measurements.tsv", glot.CSVOptions{Delimiter: '\t', Style: "lines"}, "0", "1", "2")
+func (plot *Plot) AddFromCSVAdvance(path string, options CSVOptions, xCol string, yCols ...string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	reader := csv.NewReader(f)
+	if options.Delimiter != 0 {
+		reader.Comma = options.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return &gnuplotError{err: fmt.Sprintf("%s is empty", path)}
+	}
+
+	hasHeader := options.Header == HeaderFirstRow
+	if options.Header == HeaderDetect {
+		for _, field := range records[0] {
+			if _, err := strconv.ParseFloat(strings.TrimSpace(field), 64); err != nil {
+				hasHeader = true
+				break
+			}
+		}
+	}
+	var header []string
+	if hasHeader {
+		header = records[0]
+		records = records[1:]
+	}
+	column := func(col string) ([]float64, string, error) {
+		index := -1
+		for i, name := range header {
+			if name == col {
+				index = i
+			}
+		}
+		if index < 0 {
+			i, err := strconv.Atoi(col)
+			if err != nil || i < 0 {
+				return nil, "", &gnuplotError{err: fmt.Sprintf("%s has no column '%s'", path, col)}
+			}
+			index = i
+		}
+		name := fmt.Sprintf("column %d", index)
+		if index < len(header) {
+			name = header[index]
+		}
+		values := make([]float64, len(records))
+		for i, record := range records {
+			if index >= len(record) {
+				return nil, "", &gnuplotError{err: fmt.Sprintf("%s: row %d has no column '%s'", path, i+1, col)}
+			}
+			v, err := strconv.ParseFloat(strings.TrimSpace(record[index]), 64)
+			if err != nil {
+				return nil, "", &gnuplotError{err: fmt.Sprintf("%s: row %d: %v", path, i+1, err)}
+			}
+			values[i] = v
+		}
+		return values, name, nil
+	}
+
+	var x []float64
+	if xCol != "" {
+		x, _, err = column(xCol)
+		if err != nil {
+			return err
+		}
+	}
+	style := options.Style
+	if style == "" {
+		style = defaultStyle
+	}
+	for _, yCol := range yCols {
+		y, name, err := column(yCol)
+		if err != nil {
+			return err
+		}
+		if x == nil {
+			err = plot.AddPointGroup(name, style, y)
+		} else {
+			err = plot.AddPointGroup(name, style, [][]float64{x, y})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package glot
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAddFromCSV(t *testing.T) {
+	f, _ := ioutil.TempFile("", "glot-csv-")
+	defer os.Remove(f.Name())
+	f.WriteString("time,temperature\n1,20.5\n2,21\n3,19.5\n")
+	f.Close()
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddFromCSV(f.Name(), "time", "temperature")
+	if err != nil {
+		t.Error("Expected the CSV file to be plotted, got ", err)
+	}
+	if _, exists := plot.PointGroup["temperature"]; !exists {
+		t.Error("Expected a PointGroup named after the header of the column.")
+	}
+}
+
+func TestAddFromCSVHeader(t *testing.T) {
+	f, _ := ioutil.TempFile("", "glot-csv-")
+	defer os.Remove(f.Name())
+	f.WriteString("0,2019,2020\n1,3,4\n2,5,6\n")
+	f.Close()
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	err := plot.AddFromCSVAdvance(f.Name(), CSVOptions{Header: HeaderFirstRow}, "0", "2020")
+	if err != nil {
+		t.Fatal("Expected the numeric header to be read, got ", err)
+	}
+	if _, exists := plot.PointGroup["2020"]; !exists {
+		t.Error("Expected a PointGroup named after the numeric header of the column.")
+	}
+	data, err := ioutil.ReadFile(plot.DataFiles()["2020"])
+	if err != nil || string(data) != "1 4\n2 6\n" {
+		t.Errorf("Expected the header row to be skipped, got %q, %v", data, err)
+	}
+
+	plot, _ = NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	err = plot.AddFromCSVAdvance(f.Name(), CSVOptions{Header: HeaderNone}, "0", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := plot.PointGroup["column 1"]; !exists {
+		t.Error("Expected the PointGroup to be named after the index of the column.")
+	}
+	data, err = ioutil.ReadFile(plot.DataFiles()["column 1"])
+	if err != nil || !strings.HasPrefix(string(data), "0 2019\n") {
+		t.Errorf("Expected the first row to be data, got %q, %v", data, err)
+	}
+}
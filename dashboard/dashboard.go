@@ -0,0 +1,162 @@
+// Package dashboard assembles several glot plots into a static HTML page,
+// for quick reports of experiments that can be opened in any browser.
+//
+// Usage
+//  board := dashboard.New("Training run 42")
+//  board.SetColumns(2)
+//  board.Add("Loss", lossPlot)
+//  board.Add("Accuracy", accuracyPlot)
+//  board.Save("report.html")
+package dashboard
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Arafatk/glot"
+)
+
+// Dashboard is an HTML page with plots laid out in a grid.
+type Dashboard struct {
+	title   string
+	columns int
+	refresh time.Duration
+	format  string
+	panels  []panel
+}
+
+// panel is a plot of the page with its heading.
+type panel struct {
+	title string
+	plot  *glot.Plot
+}
+
+// New makes an empty dashboard with a title, showing the plots as SVG in a single column.
+func New(title string) *Dashboard {
+	return &Dashboard{title: title, columns: 1, format: "svg"}
+}
+
+// Add appends a plot with a heading to the page. The plot is drawn when the page is rendered.
+func (board *Dashboard) Add(title string, plot *glot.Plot) {
+	board.panels = append(board.panels, panel{title: title, plot: plot})
+}
+
+// SetColumns sets the number of plots shown side by side.
+func (board *Dashboard) SetColumns(columns int) error {
+	if columns < 1 {
+		return fmt.Errorf("dashboard: invalid number of columns %d", columns)
+	}
+	board.columns = columns
+	return nil
+}
+
+// SetRefresh makes the browser reload the page periodically, for pages that are saved
+// again while an experiment runs. A refresh of 0 turns it off.
+//
+// Usage
+//  board.SetRefresh(10 * time.Second)
+//  for range time.Tick(10 * time.Second) {
+//  	board.Save("report.html")
+//  }
+func (board *Dashboard) SetRefresh(refresh time.Duration) {
+	board.refresh = refresh
+}
+
+// SetFormat sets the format the plots are embedded in, "svg" for inline vector images,
+// the default, or "png" and "pngcairo" for images embedded as data URLs.
+func (board *Dashboard) SetFormat(format string) error {
+	if format != "svg" && format != "png" && format != "pngcairo" {
+		return fmt.Errorf("dashboard: invalid format '%s'", format)
+	}
+	board.format = format
+	return nil
+}
+
+// Render draws the plots and writes the page to w.
+func (board *Dashboard) Render(w io.Writer) error {
+	page := struct {
+		Title   string
+		Columns int
+		Refresh int
+		Panels  []struct {
+			Title string
+			Image template.HTML
+		}
+	}{Title: board.title, Columns: board.columns, Refresh: int(board.refresh / time.Second)}
+	for _, p := range board.panels {
+		image, err := board.image(p.plot)
+		if err != nil {
+			return fmt.Errorf("dashboard: could not render %q: %v", p.title, err)
+		}
+		page.Panels = append(page.Panels, struct {
+			Title string
+			Image template.HTML
+		}{p.title, image})
+	}
+	return pageTemplate.Execute(w, page)
+}
+
+// Save writes the page to filename.
+func (board *Dashboard) Save(filename string) error {
+	var buf bytes.Buffer
+	err := board.Render(&buf)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	_, err = buf.WriteTo(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// image returns the HTML showing a plot.
+func (board *Dashboard) image(plot *glot.Plot) (template.HTML, error) {
+	image, err := plot.RenderBytes(board.format)
+	if err != nil {
+		return "", err
+	}
+	if board.format == "svg" {
+		// Leave out the XML declaration and doctype, which are not allowed inside HTML.
+		svg := string(image)
+		if start := strings.Index(svg, "<svg"); start >= 0 {
+			svg = svg[start:]
+		}
+		return template.HTML(svg), nil
+	}
+	return template.HTML(`<img src="data:image/png;base64,` + base64.StdEncoding.EncodeToString(image) + `">`), nil
+}
+
+var pageTemplate = template.Must(template.New("dashboard").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+{{if .Refresh}}<meta http-equiv="refresh" content="{{.Refresh}}">
+{{end}}<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 1em 2em; }
+.grid { display: grid; grid-template-columns: repeat({{.Columns}}, 1fr); gap: 1em; }
+.panel svg, .panel img { max-width: 100%; height: auto; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="grid">
+{{range .Panels}}<div class="panel">
+<h2>{{.Title}}</h2>
+{{.Image}}
+</div>
+{{end}}</div>
+</body>
+</html>
+`))
@@ -0,0 +1,28 @@
+package dashboard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	board := New("Run <1>")
+	board.SetRefresh(5 * time.Second)
+	if board.SetColumns(0) == nil {
+		t.Error("Expected an error for 0 columns.")
+	}
+	board.SetColumns(3)
+	var buf bytes.Buffer
+	err := board.Render(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := buf.String()
+	for _, expected := range []string{"<title>Run &lt;1&gt;</title>", `content="5"`, "repeat(3, 1fr)"} {
+		if !strings.Contains(page, expected) {
+			t.Errorf("Expected %q in the page:\n%s", expected, page)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package glot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// castData converts the data of a PointGroup to []float64 for 1-d data, or to
+// [][]float64 holding one slice per coordinate for multi dimensional data.
+// It accepts slices of any integer or float type, slices of such slices, and
+// slices of structs whose fields are tagged with the coordinate they hold:
+//  type Sample struct {
+//  	Time  int64   `glot:"x"`
+//  	Value float64 `glot:"y"`
+//  }
+func castData(data interface{}) (interface{}, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return nil, &gnuplotError{err: fmt.Sprintf("unsupported data type '%T'", data), kind: ErrUnsupportedDataType}
+	}
+	elem := v.Type().Elem()
+	switch {
+	case isNumber(elem.Kind()):
+		return toFloat64s(v), nil
+	case elem.Kind() == reflect.Slice && isNumber(elem.Elem().Kind()):
+		casted := make([][]float64, v.Len())
+		for i := range casted {
+			casted[i] = toFloat64s(v.Index(i))
+		}
+		return casted, nil
+	case elem.Kind() == reflect.Struct:
+		return structColumns(v)
+	}
+	return nil, &gnuplotError{err: fmt.Sprintf("unsupported data type '%T'", data), kind: ErrUnsupportedDataType}
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// toFloat64 converts a value of any integer or float type to float64.
+func toFloat64(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+func toFloat64s(v reflect.Value) []float64 {
+	casted := make([]float64, v.Len())
+	for i := range casted {
+		casted[i] = toFloat64(v.Index(i))
+	}
+	return casted
+}
+
+// structColumns collects the fields tagged x, y and z of a slice of structs into columns.
+func structColumns(v reflect.Value) ([][]float64, error) {
+	elem := v.Type().Elem()
+	var fields []int
+	for _, coordinate := range []string{"x", "y", "z"} {
+		for i := 0; i < elem.NumField(); i++ {
+			field := elem.Field(i)
+			if field.Tag.Get("glot") != coordinate {
+				continue
+			}
+			if !isNumber(field.Type.Kind()) {
+				return nil, &gnuplotError{err: fmt.Sprintf("field '%s' of '%v' is not a number", field.Name, elem), kind: ErrUnsupportedDataType}
+			}
+			fields = append(fields, i)
+		}
+	}
+	if len(fields) == 0 {
+		return nil, &gnuplotError{err: fmt.Sprintf("'%v' has no fields tagged with glot:\"x\", glot:\"y\" or glot:\"z\"", elem), kind: ErrUnsupportedDataType}
+	}
+	columns := make([][]float64, len(fields))
+	for j, field := range fields {
+		columns[j] = make([]float64, v.Len())
+		for i := range columns[j] {
+			columns[j][i] = toFloat64(v.Index(i).Field(field))
+		}
+	}
+	return columns, nil
+}
+
+// writeText writes the rows of the columns as lines of space separated values.
+// All columns must have the same length.
+func writeText(w io.Writer, columns ...[]float64) error {
+	bw := bufio.NewWriter(w)
+	buf := make([]byte, 0, 64)
+	for i := range columns[0] {
+		buf = buf[:0]
+		for j, column := range columns {
+			if j > 0 {
+				buf = append(buf, ' ')
+			}
+			buf = strconv.AppendFloat(buf, column[i], 'g', -1, 64)
+		}
+		buf = append(buf, '\n')
+		bw.Write(buf)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,40 @@
+package glot
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCastDataStruct(t *testing.T) {
+	type sample struct {
+		Time  int64   `glot:"x"`
+		Value float32 `glot:"y"`
+	}
+	casted, _ := castData([]sample{{1, 2}, {3, 4}})
+	columns := casted.([][]float64)
+	if len(columns) != 2 || columns[0][1] != 3 || columns[1][1] != 4 {
+		t.Error("Expected the tagged fields as columns, got ", columns)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	writeText(&buf, []float64{1, 2.5}, []float64{1e21, -3})
+	if buf.String() != "1 1e+21\n2.5 -3\n" {
+		t.Error("Unexpected data file ", buf.String())
+	}
+}
+
+func BenchmarkWriteText(b *testing.B) {
+	x := make([]float64, 1000000)
+	y := make([]float64, len(x))
+	for i := range x {
+		x[i] = float64(i) / 3
+		y[i] = float64(i) * 1.5
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		writeText(ioutil.Discard, x, y)
+	}
+}
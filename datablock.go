@@ -0,0 +1,127 @@
+package glot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// SetInlineData makes the plot send the data of the PointGroups added afterwards to gnuplot
+// as datablocks, like $glot_data1 << EOD, instead of writing them to temporary files.
+// No files are left behind when the program dies and the plot works on read-only
+// file systems, DumpScript and ExportScript then hold the data too.
+// The data is always sent as text, SetBinary doesn't apply to it.
+//
+// Usage
+//  plot.SetInlineData(true)
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+func (plot *Plot) SetInlineData(on bool) error {
+	if on {
+		if err := plot.requireFeature("datablock"); err != nil {
+			return err
+		}
+	}
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.inline = on
+	return nil
+}
+
+// WithInlineData sends the data of the plot as datablocks, see SetInlineData.
+func WithInlineData() Option {
+	return func(o *plotOptions) { o.inline = true }
+}
+
+// dataWriter is where the data of a PointGroup is written to, a file or a datablock.
+type dataWriter interface {
+	io.Writer
+	WriteString(s string) (int, error)
+	Name() string
+	Close() error
+}
+
+// datablock collects the data of a PointGroup and sends it to gnuplot when it is closed.
+type datablock struct {
+	plot *Plot
+	name string
+	buf  bytes.Buffer
+}
+
+func (block *datablock) Write(p []byte) (int, error) {
+	return block.buf.Write(p)
+}
+
+func (block *datablock) WriteString(s string) (int, error) {
+	return block.buf.WriteString(s)
+}
+
+func (block *datablock) Name() string {
+	return block.name
+}
+
+// Close defines the datablock in gnuplot, the plot's mu must be held.
+func (block *datablock) Close() error {
+	block.plot.blocks[block.name] = block.buf.Bytes()
+	return block.plot.sendBlock(block.name)
+}
+
+// isBlock reports whether the data of a PointGroup is in a datablock instead of a file.
+func isBlock(fname string) bool {
+	return strings.HasPrefix(fname, "$")
+}
+
+// newBlock starts the next datablock of the plot, the plot's mu must be held.
+func (plot *Plot) newBlock(pointGroup *PointGroup) *datablock {
+	plot.files++
+	pointGroup.fname = fmt.Sprintf("$glot_data%d", plot.files)
+	plot.log(LogDebug, "datablock created", "name", pointGroup.fname, "pointgroup", pointGroup.name)
+	return &datablock{plot: plot, name: pointGroup.fname}
+}
+
+// blockCmd returns the command defining a datablock with its content.
+func blockCmd(name string, content []byte) string {
+	data := string(content)
+	if data != "" && !strings.HasSuffix(data, "\n") {
+		data += "\n"
+	}
+	return name + " << EOD\n" + data + "EOD"
+}
+
+// sendBlock defines a datablock of the plot in gnuplot, the plot's mu must be held.
+func (plot *Plot) sendBlock(name string) error {
+	return plot.Cmd("%s", blockCmd(name, plot.blocks[name]))
+}
+
+// appendBlock appends the rows to the datablock of a PointGroup and defines it again,
+// the plot's mu must be held.
+func (plot *Plot) appendBlock(pointGroup *PointGroup, rows [][]float64) error {
+	buf := bytes.NewBuffer(plot.blocks[pointGroup.fname])
+	for _, row := range rows {
+		columns := make([][]float64, len(row))
+		for i := range row {
+			columns[i] = row[i : i+1]
+		}
+		err := writeText(buf, columns...)
+		if err != nil {
+			return err
+		}
+	}
+	plot.blocks[pointGroup.fname] = buf.Bytes()
+	return plot.sendBlock(pointGroup.fname)
+}
+
+// readData returns the content of a data file or datablock of the plot.
+func (plot *Plot) readData(fname string) ([]byte, error) {
+	if isBlock(fname) {
+		plot.mu.Lock()
+		defer plot.mu.Unlock()
+		content, exists := plot.blocks[fname]
+		if !exists {
+			return nil, &gnuplotError{err: fmt.Sprintf("The datablock %s does not exist.", fname)}
+		}
+		return append([]byte{}, content...), nil
+	}
+	return ioutil.ReadFile(fname)
+}
@@ -0,0 +1,39 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInlineData(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithInlineData(), WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	plot.SetBinary(true)
+	plot.AddPointGroup("Sample 1", "lines", []float64{2, 3})
+	err = plot.AppendPoint("Sample 1", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plot.tmpfiles) != 0 {
+		t.Errorf("inline data wrote files %v", plot.tmpfiles)
+	}
+	script := plot.DumpScript()
+	for _, expected := range []string{"$glot_data1 << EOD\n0 2\n1 3\n2 4\nEOD\n", `plot "$glot_data1"`} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("Expected %q in the script:\n%s", expected, script)
+		}
+	}
+	if strings.Index(script, "$glot_data1 << EOD") > strings.Index(script, `plot "$glot_data1"`) {
+		t.Errorf("Expected the datablock to be defined before it is plotted:\n%s", script)
+	}
+	err = plot.AppendPoint("Sample 1", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(plot.DumpScript(), "$glot_data1 << EOD"); n != 1 {
+		t.Errorf("Expected the datablock to be defined once in the history, got %d definitions", n)
+	}
+}
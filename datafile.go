@@ -0,0 +1,95 @@
+package glot
+
+import (
+	"fmt"
+	"io"
+)
+
+// AddDataFile adds a PointGroup that plots an existing data file in gnuplot's
+// whitespace separated format. The file is referenced by the plot command as it is,
+// so large data sets are not copied into temporary files.
+// The using argument selects the columns like gnuplot's using, e.g. "1:3",
+// all columns are used when it is empty.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddDataFile("Measurements", "/data/measurements.dat", "1:3", "lines")
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddDataFile(name, path, using, style string) error {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	return plot.addDataFile(name, path, using, style)
+}
+
+// AddDataReader adds a PointGroup that plots the data read from r in gnuplot's
+// whitespace separated format, like the output of another program.
+// The data is copied into a temporary file as it is read, without parsing it in Go.
+//
+// Usage
+//  resp, _ := http.Get("http://localhost:8080/metrics.dat")
+//  defer resp.Body.Close()
+//  plot.AddDataReader("Metrics", resp.Body, "1:2", "lines")
+func (plot *Plot) AddDataReader(name string, r io.Reader, using, style string) error {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	if _, exists := plot.PointGroup[name]; exists {
+		return &gnuplotError{err: fmt.Sprintf("A PointGroup with the name %s  already exists, please use another name of the curve or remove this curve before using another one with the same name.", name), kind: ErrDuplicatePointGroup}
+	}
+	f, err := plot.tempFile()
+	if err != nil {
+		return err
+	}
+	plot.tmpfiles[f.Name()] = f
+	_, err = io.Copy(f, r)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	return plot.addDataFile(name, f.Name(), using, style)
+}
+
+// addDataFile is AddDataFile for the callers holding mu.
+func (plot *Plot) addDataFile(name, path, using, style string) error {
+	_, exists := plot.PointGroup[name]
+	if exists {
+		return &gnuplotError{err: fmt.Sprintf("A PointGroup with the name %s  already exists, please use another name of the curve or remove this curve before using another one with the same name.", name), kind: ErrDuplicatePointGroup}
+	}
+	if style == "" {
+		style = defaultStyle
+	}
+	curve := &PointGroup{
+		name:       name,
+		dimensions: plot.dimensions,
+		style:      style,
+		data:       path,
+		castedData: path,
+		set:        true,
+		fname:      path,
+		plot:       plot,
+	}
+
+	cmd := plot.plotcmd
+	if plot.dimensions == 3 {
+		cmd = "splot"
+	}
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	line := fmt.Sprintf("%s %s", cmd, quote(path))
+	if using != "" {
+		line = fmt.Sprintf("%s using %s", line, using)
+	}
+	if name != "" {
+		line = fmt.Sprintf("%s title %s", line, plot.text(name))
+	}
+	line = fmt.Sprintf("%s with %s", line, style)
+	err := plot.sendPlotLine(curve, line)
+	if err != nil {
+		return err
+	}
+	plot.PointGroup[name] = curve
+	return nil
+}
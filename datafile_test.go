@@ -0,0 +1,40 @@
+package glot
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestAddDataFile(t *testing.T) {
+	fake := &FakePlotter{}
+	plot, _ := NewPlotWithOptions(WithPlotter(fake))
+	err := plot.AddDataFile("Measurements", "/data/measurements.dat", "1:3", "lines")
+	if err != nil {
+		t.Fatal(err)
+	}
+	commands := fake.Commands()
+	if commands[len(commands)-1] != `plot "/data/measurements.dat" using 1:3 title "Measurements" with lines lc rgb "#4e79a7"` {
+		t.Error("Unexpected plot command ", commands[len(commands)-1])
+	}
+	fake.Err = errors.New("broken pipe")
+	if plot.AddDataFile("Broken", "/data/broken.dat", "", "") == nil {
+		t.Error("Expected the error of the plotter.")
+	}
+	if _, exists := plot.PointGroup["Broken"]; exists {
+		t.Error("Expected the curve that failed to plot not to be added.")
+	}
+}
+
+func TestAddDataReader(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	err := plot.AddDataReader("Metrics", strings.NewReader("1 2\n2 4\n"), "1:2", "points")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(plot.PointGroup["Metrics"].fname)
+	if err != nil || string(data) != "1 2\n2 4\n" {
+		t.Errorf("Expected the data that was read, got %q, %v", data, err)
+	}
+}
@@ -0,0 +1,87 @@
+package glot
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// CommandHistory returns every command sent to gnuplot by the plot, in order.
+func (plot *Plot) CommandHistory() []string {
+	plot.cmdMu.Lock()
+	defer plot.cmdMu.Unlock()
+	return append([]string{}, plot.history...)
+}
+
+// DumpDebug writes what is needed to reproduce a plot that renders wrong to dir:
+//  commands.gp  every command sent to gnuplot
+//  data*.dat    copies of the data files of the PointGroups, named in files.txt
+//  errors.txt   the errors gnuplot printed that were not returned by Errors yet
+//  version.txt  the version of gnuplot
+//  env.txt      the platform and the environment variables gnuplot depends on
+// The directory is created when it doesn't exist.
+//
+// Usage
+//  if err := plot.SavePlot("1.png"); err != nil {
+//  	plot.DumpDebug("glot-debug")
+//  }
+func (plot *Plot) DumpDebug(dir string) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	write := func(name string, content []byte) {
+		if err == nil {
+			err = ioutil.WriteFile(filepath.Join(dir, name), content, 0644)
+		}
+	}
+	write("commands.gp", []byte(plot.DumpScript()))
+
+	var files bytes.Buffer
+	plot.mu.Lock()
+	pointGroups := plot.sortedPointGroups()
+	plot.mu.Unlock()
+	for i, pointGroup := range pointGroups {
+		if pointGroup.fname == "" {
+			continue
+		}
+		name := fmt.Sprintf("data%d.dat", i)
+		data, readErr := plot.readData(pointGroup.fname)
+		if readErr != nil {
+			fmt.Fprintf(&files, "%s\t%s\t%v\n", pointGroup.name, pointGroup.fname, readErr)
+			continue
+		}
+		write(name, data)
+		fmt.Fprintf(&files, "%s\t%s\t%s\n", pointGroup.name, pointGroup.fname, name)
+	}
+	write("files.txt", files.Bytes())
+
+	var errs bytes.Buffer
+	if plot.proc != nil && plot.proc.errlog != nil {
+		log := plot.proc.errlog
+		log.catchUp()
+		log.mu.Lock()
+		for _, e := range log.errs {
+			fmt.Fprintln(&errs, e)
+		}
+		log.mu.Unlock()
+	}
+	write("errors.txt", errs.Bytes())
+
+	version, versionErr := Available()
+	if versionErr != nil {
+		version = versionErr.Error()
+	}
+	write("version.txt", []byte(version+"\n"))
+
+	var env bytes.Buffer
+	fmt.Fprintf(&env, "GOOS=%s\nGOARCH=%s\nGOVERSION=%s\ngnuplot=%s\n", runtime.GOOS, runtime.GOARCH, runtime.Version(), gGnuplotCmd)
+	for _, name := range []string{gnuplotEnv, "GNUTERM", "GNUPLOT_LIB", "DISPLAY", "WAYLAND_DISPLAY", "TERM", "LANG", "LC_ALL", "TMPDIR"} {
+		fmt.Fprintf(&env, "%s=%s\n", name, os.Getenv(name))
+	}
+	write("env.txt", env.Bytes())
+	return err
+}
@@ -0,0 +1,35 @@
+package glot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDumpDebug(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	plot.SetTitle("Test")
+	history := plot.CommandHistory()
+	if len(history) != 2 || history[1] != `set title "Test"` {
+		t.Error("Unexpected history ", history)
+	}
+	dir, _ := ioutil.TempDir("", "glot")
+	defer os.RemoveAll(dir)
+	err := plot.DumpDebug(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"commands.gp", "data0.dat", "files.txt", "errors.txt", "version.txt", "env.txt"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Error(err)
+		}
+	}
+	commands, _ := ioutil.ReadFile(filepath.Join(dir, "commands.gp"))
+	if !strings.HasSuffix(string(commands), "set title \"Test\"\n") {
+		t.Error("Unexpected commands ", string(commands))
+	}
+}
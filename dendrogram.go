@@ -0,0 +1,98 @@
+package glot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Merge is a step of a hierarchical clustering, in which the clusters Left and Right
+// merge at the Height. Of n labels, the clusters 0 to n-1 are the leaves and
+// cluster n+i is the cluster of the i-th merge, like in SciPy's linkage matrices.
+type Merge struct {
+	Left   int
+	Right  int
+	Height float64
+}
+
+// AddDendrogram draws the tree of a hierarchical clustering of the labels, with the leaves
+// along the x-axis and every merge as a link at its height, ordered so that no links cross.
+// The merges are the n-1 steps of the clustering of n labels, the last one the root.
+//
+// Usage
+//  labels := []string{"a", "b", "c", "d"}
+//  merges := []glot.Merge{
+//  	{Left: 0, Right: 1, Height: 0.5},
+//  	{Left: 2, Right: 3, Height: 0.8},
+//  	{Left: 4, Right: 5, Height: 2},
+//  }
+//  plot.AddDendrogram("Clusters", labels, merges)
+func (plot *Plot) AddDendrogram(name string, labels []string, merges []Merge) error {
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("Dendrograms must be 2-d plots."), kind: ErrInvalidDimensions}
+	}
+	n := len(labels)
+	if n < 2 || len(merges) != n-1 {
+		return &gnuplotError{err: fmt.Sprintf("A dendrogram of %d labels needs %d merges, got %d.", n, n-1, len(merges)), kind: ErrLengthMismatch}
+	}
+	merged := make([]bool, 2*n-1)
+	for i, merge := range merges {
+		for _, child := range []int{merge.Left, merge.Right} {
+			if child < 0 || child >= n+i || merged[child] {
+				return &gnuplotError{err: fmt.Sprintf("The merge %d of the dendrogram merges the invalid cluster %d.", i, child)}
+			}
+			merged[child] = true
+		}
+	}
+
+	// Place the leaves in the order of the tree and every cluster in the middle of its children.
+	x := make([]float64, 2*n-1)
+	height := make([]float64, 2*n-1)
+	order := make([]int, 0, n)
+	var place func(cluster int)
+	place = func(cluster int) {
+		if cluster < n {
+			order = append(order, cluster)
+			x[cluster] = float64(len(order))
+			return
+		}
+		merge := merges[cluster-n]
+		place(merge.Left)
+		place(merge.Right)
+		x[cluster] = (x[merge.Left] + x[merge.Right]) / 2
+		height[cluster] = merge.Height
+	}
+	place(2*n - 2)
+
+	// Every merge is drawn as the links up from its children and the bar between them.
+	var data VectorData
+	data.Head = "nohead"
+	link := func(x1, y1, x2, y2 float64) {
+		data.X = append(data.X, x1)
+		data.Y = append(data.Y, y1)
+		data.DX = append(data.DX, x2-x1)
+		data.DY = append(data.DY, y2-y1)
+	}
+	for i, merge := range merges {
+		h := height[n+i]
+		left, right := merge.Left, merge.Right
+		link(x[left], height[left], x[left], h)
+		link(x[right], height[right], x[right], h)
+		link(x[left], h, x[right], h)
+	}
+
+	tics := make([]string, n)
+	plot.mu.Lock()
+	for i, leaf := range order {
+		tics[i] = fmt.Sprintf("%s %d", plot.text(labels[leaf]), i+1)
+	}
+	plot.mu.Unlock()
+	err := plot.set("xtics", "set xtics (%s) nomirror", strings.Join(tics, ", "))
+	if err != nil {
+		return err
+	}
+	err = plot.set("xrange", "set xrange [0.5:%v]", float64(n)+0.5)
+	if err != nil {
+		return err
+	}
+	return plot.AddPointGroup(name, "vectors", data)
+}
@@ -0,0 +1,29 @@
+package glot
+
+import "testing"
+
+func TestAddDendrogram(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	labels := []string{"a", "b", "c", "d"}
+	if plot.AddDendrogram("Clusters", labels, []Merge{{1, 2, 0.5}, {0, 5, 1}, {4, 5, 2}}) == nil {
+		t.Error("Expected an error for a merge of a cluster that doesn't exist yet.")
+	}
+	if plot.AddDendrogram("Clusters", labels, []Merge{{1, 2, 0.5}, {0, 3, 1}}) == nil {
+		t.Error("Expected an error for a missing merge.")
+	}
+	err := plot.AddDendrogram("Clusters", labels, []Merge{{1, 2, 0.5}, {0, 3, 1}, {4, 5, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plot.hasSetting("xtics") || !plot.hasSetting("xrange") {
+		t.Error("Expected the labels and the range of the leaves to be kept, got ", plot.options)
+	}
+	data := plot.PointGroup["Clusters"].castedData.(VectorData)
+	if len(data.X) != 9 || data.Head != "nohead" {
+		t.Fatal("Expected 3 links per merge, got ", len(data.X))
+	}
+	// The leaves are ordered b, c, a, d, so the root joins 1.5 and 3.5.
+	if data.X[8] != 1.5 || data.DX[8] != 2 || data.Y[8] != 2 {
+		t.Error("Expected the bar of the root from x 1.5 to 3.5 at 2, got ", data.X[8], data.X[8]+data.DX[8], data.Y[8])
+	}
+}
@@ -0,0 +1,51 @@
+package glot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DeterministicTerminal holds the terminal options of the plots made with WithDeterministic.
+var DeterministicTerminal = TerminalOptions{Width: 640, Height: 480, Font: "DejaVu Sans,10"}
+
+// WithDeterministic makes the plot produce the same script and the same images, byte for byte,
+// every time it is drawn with the same data, for golden files and caches:
+//  - the data files are numbered in order, like go-gnuplot-data1.dat, instead of random names
+//  - the plot is saved and rendered with DeterministicTerminal, unless SetTerminalOptions is called
+//  - gnuplot is run with -d, so the settings of ~/.gnuplot don't apply
+// The names of the data files only repeat when no other plot uses the same directory at the
+// same time, so give every plot a directory of its own with WithTempDir.
+//
+// Usage
+//  plot, _ := glot.NewPlotWithOptions(glot.WithDeterministic(), glot.WithTempDir("testdata/tmp"))
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  plot.Render(w, "svg")
+func WithDeterministic() Option {
+	return func(o *plotOptions) { o.stable = true }
+}
+
+// gnuplotArgs returns the arguments gnuplot is run with for the plot.
+func (plot *Plot) gnuplotArgs() []string {
+	if plot.stable {
+		return []string{"-d"}
+	}
+	return nil
+}
+
+// stableFile creates the next data file of a deterministic plot in dir.
+// A file of that name that exists already, of another plot, is left alone.
+func (plot *Plot) stableFile(dir string) (*os.File, error) {
+	plot.files++
+	for n := 1; ; n++ {
+		name := fmt.Sprintf("%sdata%d.dat", gGnuplotPrefix, plot.files)
+		if n > 1 {
+			name = fmt.Sprintf("%sdata%d-%d.dat", gGnuplotPrefix, plot.files, n)
+		}
+		f, err := os.OpenFile(filepath.Join(dir, name), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+		if os.IsExist(err) {
+			continue
+		}
+		return f, err
+	}
+}
@@ -0,0 +1,35 @@
+package glot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDeterministic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "glot-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	script := func() string {
+		plot, err := NewPlotWithOptions(WithDeterministic(), WithDryRun(), WithTempDir(dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer plot.Close()
+		plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+		plot.AddPointGroup("Sample 2", "points", []float64{1, 2, 3})
+		plot.SavePlot("1.png")
+		if name := plot.DataFiles()["Sample 2"]; name != filepath.Join(dir, "go-gnuplot-data2.dat") {
+			t.Errorf("data file of Sample 2 = %s", name)
+		}
+		return plot.DumpScript()
+	}
+	first, second := script(), script()
+	if first != second {
+		t.Errorf("the scripts differ:\n%s\n%s", first, second)
+	}
+}
@@ -0,0 +1,159 @@
+package glot
+
+import "math"
+
+// Downsampling is a strategy to reduce the number of points of a PointGroup.
+type Downsampling int
+
+// Downsampling strategies
+const (
+	// DownsampleEveryNth keeps every n-th point.
+	DownsampleEveryNth Downsampling = iota
+	// DownsampleMinMax splits the points into buckets and keeps the lowest
+	// and highest point of every bucket, which preserves spikes.
+	DownsampleMinMax
+	// DownsampleLTTB keeps the points that best preserve the shape of the curve,
+	// using the Largest-Triangle-Three-Buckets algorithm.
+	DownsampleLTTB
+)
+
+// SetMaxPoints limits the number of points written for every 1-d, 2-d or 3-d
+// PointGroup added afterwards to about maxPoints, so that plots of huge series stay
+// responsive. The points are selected in Go with the given strategy.
+// 3-d PointGroups are always reduced with DownsampleEveryNth.
+// A maxPoints of 0 turns sampling off.
+//
+// Usage
+//  plot.SetMaxPoints(2000, glot.DownsampleLTTB)
+//  plot.AddPointGroup("Sensor", "lines", [][]float64{timestamps, values})
+func (plot *Plot) SetMaxPoints(maxPoints int, strategy Downsampling) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.maxPoints = maxPoints
+	plot.sampling = strategy
+}
+
+// downsample returns the columns reduced to about n points.
+// The first column holds x and the second y, a single column holds y with
+// the index as x.
+func downsample(columns [][]float64, n int, strategy Downsampling) [][]float64 {
+	length := len(columns[0])
+	for _, column := range columns {
+		if len(column) < length {
+			length = len(column)
+		}
+	}
+	if n <= 0 || length <= n {
+		return columns
+	}
+
+	var x, y []float64
+	switch len(columns) {
+	case 1:
+		y = columns[0]
+	case 2:
+		x, y = columns[0], columns[1]
+	default:
+		strategy = DownsampleEveryNth
+	}
+	var indices []int
+	switch strategy {
+	case DownsampleMinMax:
+		indices = minMaxIndices(y[:length], n)
+	case DownsampleLTTB:
+		indices = lttbIndices(x, y[:length], n)
+	default:
+		step := int(math.Ceil(float64(length) / float64(n)))
+		for i := 0; i < length; i += step {
+			indices = append(indices, i)
+		}
+	}
+
+	sampled := make([][]float64, len(columns))
+	for j, column := range columns {
+		sampled[j] = make([]float64, len(indices))
+		for i, index := range indices {
+			sampled[j][i] = column[index]
+		}
+	}
+	return sampled
+}
+
+// minMaxIndices returns the index of the lowest and highest value of
+// n/2 buckets, in order.
+func minMaxIndices(y []float64, n int) []int {
+	buckets := n / 2
+	if buckets < 1 {
+		buckets = 1
+	}
+	size := float64(len(y)) / float64(buckets)
+	indices := make([]int, 0, 2*buckets)
+	for b := 0; b < buckets; b++ {
+		start, end := int(float64(b)*size), int(float64(b+1)*size)
+		if b == buckets-1 {
+			end = len(y)
+		}
+		low, high := start, start
+		for i := start; i < end; i++ {
+			if y[i] < y[low] {
+				low = i
+			}
+			if y[i] > y[high] {
+				high = i
+			}
+		}
+		if low > high {
+			low, high = high, low
+		}
+		indices = append(indices, low)
+		if high != low {
+			indices = append(indices, high)
+		}
+	}
+	return indices
+}
+
+// lttbIndices selects n points with the Largest-Triangle-Three-Buckets algorithm.
+// The x values are the indices when x is nil.
+func lttbIndices(x, y []float64, n int) []int {
+	if n < 3 {
+		n = 3
+	}
+	xAt := func(i int) float64 {
+		if x == nil {
+			return float64(i)
+		}
+		return x[i]
+	}
+	indices := make([]int, 0, n)
+	indices = append(indices, 0)
+	size := float64(len(y)-2) / float64(n-2)
+	a := 0
+	for b := 0; b < n-2; b++ {
+		// The average of the next bucket is the third point of the triangle.
+		nextStart, nextEnd := int(float64(b+1)*size)+1, int(float64(b+2)*size)+1
+		if nextEnd > len(y) {
+			nextEnd = len(y)
+		}
+		var avgX, avgY float64
+		for i := nextStart; i < nextEnd; i++ {
+			avgX += xAt(i)
+			avgY += y[i]
+		}
+		count := float64(nextEnd - nextStart)
+		avgX /= count
+		avgY /= count
+
+		start, end := int(float64(b)*size)+1, int(float64(b+1)*size)+1
+		maxArea, next := -1.0, start
+		for i := start; i < end; i++ {
+			area := math.Abs((xAt(a)-avgX)*(y[i]-y[a]) - (xAt(a)-xAt(i))*(avgY-y[a]))
+			if area > maxArea {
+				maxArea, next = area, i
+			}
+		}
+		indices = append(indices, next)
+		a = next
+	}
+	return append(indices, len(y)-1)
+}
@@ -0,0 +1,31 @@
+package glot
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestDownsampleLTTB(t *testing.T) {
+	y := make([]float64, 1000)
+	for i := range y {
+		y[i] = float64(i % 7)
+	}
+	sampled := downsample([][]float64{y}, 100, DownsampleLTTB)
+	if len(sampled[0]) != 100 {
+		t.Error("Expected 100 points, got ", len(sampled[0]))
+	}
+}
+
+func TestDownsample1d(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	plot.SetMaxPoints(4, DownsampleMinMax)
+	plot.AddPointGroup("Spikes", "lines", []float64{0, 0, 9, 0, 0, 0, 0, -9, 0, 0})
+	data, err := ioutil.ReadFile(plot.DataFiles()["Spikes"])
+	if err != nil || string(data) != "0 0\n2 9\n5 0\n7 -9\n" {
+		t.Errorf("Expected the indexes of the kept points as x, got %q, %v", data, err)
+	}
+	if spec := plot.PointGroup["Spikes"].specs[0]; !strings.Contains(spec, " using 1:2 ") {
+		t.Error("Expected the points to be plotted with their x, got ", spec)
+	}
+}
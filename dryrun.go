@@ -0,0 +1,56 @@
+package glot
+
+import (
+	"context"
+	"io"
+)
+
+// WithDryRun makes a plot without a gnuplot process, that only records the commands
+// it would send to gnuplot and writes the data files of its PointGroups.
+// Nothing is drawn and saving or rendering the plot writes no image,
+// so plotting code can be tested where gnuplot is not installed,
+// by comparing CommandHistory or DumpScript to a golden file.
+//
+// Usage
+//  plot, _ := glot.NewPlotWithOptions(glot.WithDryRun())
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  plot.SavePlot("1.png")
+//  fmt.Print(plot.DumpScript())
+func WithDryRun() Option {
+	return func(o *plotOptions) { o.dryRun = true }
+}
+
+// DataFiles returns the data files of the PointGroups by their names, or the names of their
+// datablocks with SetInlineData. The PointGroups without data, like functions, are left out.
+func (plot *Plot) DataFiles() map[string]string {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	files := make(map[string]string)
+	for name, pointGroup := range plot.PointGroup {
+		if pointGroup.fname != "" {
+			files[name] = pointGroup.fname
+		}
+	}
+	return files
+}
+
+// runScript runs the commands in a gnuplot process of their own,
+// or only records them when the plot is a dry run or has a Plotter of its own.
+func (plot *Plot) runScript(ctx context.Context, commands []string, w io.Writer) error {
+	if !plot.dryRun && plot.plotter == nil {
+		return runScript(ctx, commands, w, plot.getLogger(), plot.gnuplotArgs()...)
+	}
+	plot.cmdMu.Lock()
+	defer plot.cmdMu.Unlock()
+	for _, cmd := range commands {
+		plot.history = append(plot.history, cmd)
+		plot.log(LogDebug, "command", "cmd", cmd)
+		if plot.plotter != nil {
+			err := plot.plotter.SendCommand(cmd)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package glot
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	saved := gGnuplotCmd
+	gGnuplotCmd = ""
+	defer func() { gGnuplotCmd = saved }()
+
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+	plot.SetTitle("Test Results")
+	err = plot.SavePlot("1.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := plot.DumpScript()
+	for _, want := range []string{`set title "Test Results"`, `set output "1.png"`, "replot"} {
+		if !strings.Contains(script, want) {
+			t.Errorf("script lacks %q:\n%s", want, script)
+		}
+	}
+	files := plot.DataFiles()
+	data, err := ioutil.ReadFile(files["Sample 1"])
+	if err != nil || !strings.HasPrefix(string(data), "0 2\n1 3\n") {
+		t.Errorf("data file %q = %q, %v", files["Sample 1"], data, err)
+	}
+}
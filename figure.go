@@ -0,0 +1,146 @@
+package glot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Figure arranges several plots in a grid and saves them as a single image.
+// Every plot keeps its own settings (title, labels, ranges, ...) and curves.
+type Figure struct {
+	rows   int
+	cols   int
+	plots  map[int]*Plot // plots by cell, row*cols + col
+	format string
+	debug  bool
+}
+
+// NewFigure makes a figure with the given number of rows and columns.
+//
+// Usage
+//  fig, _ := glot.NewFigure(1, 2, false)
+//  left, _ := glot.NewPlot(2, false, false)
+//  left.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  right, _ := glot.NewPlot(2, false, false)
+//  right.AddPointGroup("Sample 2", "points", []float64{1, 4, 2, 3})
+//  fig.Add(left, 0, 0)
+//  fig.Add(right, 0, 1)
+//  fig.Save("1.png")
+func NewFigure(rows, cols int, debug bool) (*Figure, error) {
+	if rows < 1 || cols < 1 {
+		return nil, &gnuplotError{err: fmt.Sprintf("invalid layout '%v,%v'", rows, cols)}
+	}
+	return &Figure{rows: rows, cols: cols, plots: make(map[int]*Plot), format: "png", debug: debug}, nil
+}
+
+// Add places a plot in the cell at the given row and column, replacing any previous plot of that cell.
+// Rows and columns are counted from 0, starting at the top left.
+func (fig *Figure) Add(plot *Plot, row, col int) error {
+	if row < 0 || row >= fig.rows || col < 0 || col >= fig.cols {
+		return &gnuplotError{err: fmt.Sprintf("cell '%v,%v' is outside of the '%v,%v' layout", row, col, fig.rows, fig.cols)}
+	}
+	fig.plots[row*fig.cols+col] = plot
+	return nil
+}
+
+// SetFormat sets the format the figure is saved in, see Plot.SetFormat.
+func (fig *Figure) SetFormat(newformat string) error {
+	if isAllowedFormat(newformat) {
+		fig.format = newformat
+		return nil
+	}
+	return &gnuplotError{err: fmt.Sprintf("invalid format '%s'", newformat)}
+}
+
+// Save draws all plots of the figure with gnuplot's multiplot mode and writes the result to filename.
+// Empty cells are left blank.
+func (fig *Figure) Save(filename string) error {
+	commands := []string{
+		terminalCommand(fig.format, TerminalOptions{}),
+		"set output " + quote(filename),
+		fmt.Sprintf("set multiplot layout %d,%d", fig.rows, fig.cols),
+	}
+	for cell := 0; cell < fig.rows*fig.cols; cell++ {
+		plot, exists := fig.plots[cell]
+		if !exists || plot.empty() {
+			commands = append(commands, "set multiplot next")
+			continue
+		}
+		commands = append(commands, "reset")
+		commands = append(commands, plot.settings()...)
+		commands = append(commands, plot.plotAll())
+	}
+	commands = append(commands, "unset multiplot", "unset output")
+
+	return runScript(context.Background(), commands, nil, debugLogger(fig.debug))
+}
+
+// settings returns the commands that configured the plot, leaving out the
+// commands that draw the curves or select the output of the plot.
+func (plot *Plot) settings() []string {
+	plot.cmdMu.Lock()
+	defer plot.cmdMu.Unlock()
+	var settings []string
+	for _, cmd := range plot.history {
+		if strings.HasPrefix(cmd, "plot ") || strings.HasPrefix(cmd, "splot ") ||
+			strings.HasPrefix(cmd, "replot") || strings.HasPrefix(cmd, "set terminal") ||
+			strings.HasPrefix(cmd, "set output") {
+			continue
+		}
+		settings = append(settings, cmd)
+	}
+	return settings
+}
+
+// plotAll returns a single command drawing all PointGroups of the plot in the order they were plotted.
+func (plot *Plot) plotAll() string {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	return plot.plotAllCmd()
+}
+
+// plotAllCmd is plotAll for the callers holding mu.
+func (plot *Plot) plotAllCmd() string {
+	pointGroups := plot.sortedPointGroups()
+	specs := make([]string, len(pointGroups))
+	for i, pointGroup := range pointGroups {
+		specs[i] = pointGroup.plotSpec()
+	}
+	cmd := "plot"
+	if plot.dimensions == 3 {
+		cmd = "splot"
+	}
+	// A sampling range of the first PointGroup would be taken for the range of the x-axis.
+	if len(specs) > 0 && strings.HasPrefix(specs[0], "[") {
+		cmd += " sample"
+	}
+	return cmd + " " + strings.Join(specs, ", ")
+}
+
+// plottedPointGroups returns the plotted PointGroups in the order they were plotted.
+func (plot *Plot) plottedPointGroups() []*PointGroup {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	return plot.sortedPointGroups()
+}
+
+// sortedPointGroups is plottedPointGroups for the callers holding mu.
+func (plot *Plot) sortedPointGroups() []*PointGroup {
+	pointGroups := make(byIndex, 0, len(plot.PointGroup))
+	for _, pointGroup := range plot.PointGroup {
+		if len(pointGroup.specs) > 0 {
+			pointGroups = append(pointGroups, pointGroup)
+		}
+	}
+	sort.Sort(pointGroups)
+	return pointGroups
+}
+
+// byIndex sorts PointGroups by their position in the plot command.
+type byIndex []*PointGroup
+
+func (s byIndex) Len() int           { return len(s) }
+func (s byIndex) Less(i, j int) bool { return s[i].index < s[j].index }
+func (s byIndex) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
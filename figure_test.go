@@ -0,0 +1,12 @@
+package glot
+
+import "testing"
+
+func TestFigureAdd(t *testing.T) {
+	fig, _ := NewFigure(1, 2, false)
+	plot, _ := NewPlot(2, false, false)
+	err := fig.Add(plot, 1, 0)
+	if err == nil {
+		t.Error("Expected error when adding a plot outside of the layout.")
+	}
+}
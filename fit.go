@@ -0,0 +1,206 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Fit is a polynomial fitted to data by least squares, see AddFit.
+type Fit struct {
+	Coefficients []float64 // Coefficients[i] is the coefficient of x^i
+	RSquared     float64   // Coefficient of determination, 1 for a perfect fit
+}
+
+// Eval returns the value of the fitted polynomial at x.
+func (fit *Fit) Eval(x float64) float64 {
+	y := 0.0
+	for i := len(fit.Coefficients) - 1; i >= 0; i-- {
+		y = y*x + fit.Coefficients[i]
+	}
+	return y
+}
+
+// superscripts are the UTF-8 superscript digits, for the powers of x.
+var superscripts = strings.NewReplacer("0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+	"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹")
+
+// String returns the equation of the fit, like "y = 2.5x² - 0.3x + 1".
+func (fit *Fit) String() string {
+	var terms []string
+	for i := len(fit.Coefficients) - 1; i >= 0; i-- {
+		c := fit.Coefficients[i]
+		if c == 0 && (i > 0 || len(terms) > 0) {
+			continue
+		}
+		sign := "+"
+		if c < 0 {
+			sign, c = "-", -c
+		}
+		term := fmt.Sprintf("%.4g", c)
+		switch {
+		case i == 1:
+			term += "x"
+		case i > 1:
+			term += "x" + superscripts.Replace(fmt.Sprint(i))
+		}
+		if len(terms) == 0 {
+			if sign == "-" {
+				term = "-" + term
+			}
+			terms = append(terms, term)
+		} else {
+			terms = append(terms, sign, term)
+		}
+	}
+	return "y = " + strings.Join(terms, " ")
+}
+
+// AddFit fits a polynomial of the degree to the points x,y by least squares, 1 for a
+// straight line, and draws it as a line named name over the range of x.
+// The points themselves are not drawn, add them as a PointGroup of their own.
+//
+// Usage
+//  plot.AddPointGroup("Samples", "points", [][]float64{x, y})
+//  fit, _ := plot.AddFit("Trend", x, y, 2)
+//  plot.AnnotateFit(fit)
+func (plot *Plot) AddFit(name string, x, y []float64, degree int) (*Fit, error) {
+	fit, err := fitPolynomial(x, y, degree)
+	if err != nil {
+		return nil, err
+	}
+	xmin, xmax := x[0], x[0]
+	for _, v := range x {
+		xmin, xmax = math.Min(xmin, v), math.Max(xmax, v)
+	}
+	samples := 200
+	if degree <= 1 {
+		samples = 2
+	}
+	return fit, plot.AddFunc(name, fit.Eval, xmin, xmax, samples)
+}
+
+// AnnotateFit writes the equation and the R² of the fit in the top left corner of the graph.
+func (plot *Plot) AnnotateFit(fit *Fit) (*Annotation, error) {
+	plot.mu.Lock()
+	quoted := plot.text(fmt.Sprintf("%s, R² = %.4f", fit, fit.RSquared))
+	plot.mu.Unlock()
+	return plot.annotate("label", "%s at graph 0.03,0.95 left front", quoted)
+}
+
+// fitPolynomial fits a polynomial of the degree to the points by solving the normal equations.
+// x is centered and scaled first, so that high powers of large x don't lose the precision.
+func fitPolynomial(x, y []float64, degree int) (*Fit, error) {
+	if len(x) != len(y) {
+		return nil, &gnuplotError{err: fmt.Sprintf("The lengths of x (%d) and y (%d) differ.", len(x), len(y)), kind: ErrLengthMismatch}
+	}
+	if degree < 0 || len(x) <= degree {
+		return nil, &gnuplotError{err: fmt.Sprintf("A polynomial of degree %d can't be fitted to %d points.", degree, len(x))}
+	}
+	n := degree + 1
+	mean, scale := 0.0, 0.0
+	for _, v := range x {
+		mean += v
+	}
+	mean /= float64(len(x))
+	for _, v := range x {
+		scale = math.Max(scale, math.Abs(v-mean))
+	}
+	if scale == 0 {
+		scale = 1
+	}
+
+	// The normal equations A a = b of the scaled x.
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, n+1)
+	}
+	for k := range x {
+		t := (x[k] - mean) / scale
+		powers := make([]float64, 2*n)
+		powers[0] = 1
+		for i := 1; i < len(powers); i++ {
+			powers[i] = powers[i-1] * t
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a[i][j] += powers[i+j]
+			}
+			a[i][n] += powers[i] * y[k]
+		}
+	}
+	scaled, ok := solve(a)
+	if !ok {
+		return nil, &gnuplotError{err: fmt.Sprintf("A polynomial of degree %d can't be fitted to points with %d distinct x.", degree, distinct(x))}
+	}
+
+	// Expand sum scaled[k]*((x-mean)/scale)^k into the powers of x.
+	coefficients := make([]float64, n)
+	for k, c := range scaled {
+		c /= math.Pow(scale, float64(k))
+		binomial := 1.0
+		for j := k; j >= 0; j-- {
+			coefficients[j] += c * binomial * math.Pow(-mean, float64(k-j))
+			binomial = binomial * float64(j) / float64(k-j+1)
+		}
+	}
+
+	fit := &Fit{Coefficients: coefficients, RSquared: 1}
+	yMean := 0.0
+	for _, v := range y {
+		yMean += v
+	}
+	yMean /= float64(len(y))
+	var residual, total float64
+	for k := range x {
+		r := y[k] - fit.Eval(x[k])
+		residual += r * r
+		total += (y[k] - yMean) * (y[k] - yMean)
+	}
+	if total > 0 {
+		fit.RSquared = 1 - residual/total
+	}
+	return fit, nil
+}
+
+// solve solves the linear equations of the augmented matrix by Gaussian elimination
+// with partial pivoting. It reports false when the equations are singular.
+func solve(a [][]float64) ([]float64, bool) {
+	n := len(a)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for row := col + 1; row < n; row++ {
+			f := a[row][col] / a[col][col]
+			for j := col; j <= n; j++ {
+				a[row][j] -= f * a[col][j]
+			}
+		}
+	}
+	solution := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := a[row][n]
+		for j := row + 1; j < n; j++ {
+			sum -= a[row][j] * solution[j]
+		}
+		solution[row] = sum / a[row][row]
+	}
+	return solution, true
+}
+
+// distinct returns the number of distinct values.
+func distinct(values []float64) int {
+	seen := make(map[float64]bool)
+	for _, v := range values {
+		seen[v] = true
+	}
+	return len(seen)
+}
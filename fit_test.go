@@ -0,0 +1,37 @@
+package glot
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFitPolynomial(t *testing.T) {
+	var x, y []float64
+	for v := 100.0; v < 106; v++ {
+		x = append(x, v)
+		y = append(y, 2*v*v-3*v+1)
+	}
+	fit, err := fitPolynomial(x, y, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expected := range []float64{1, -3, 2} {
+		if math.Abs(fit.Coefficients[i]-expected) > 1e-4 {
+			t.Errorf("Expected the coefficients 1, -3, 2, got %v", fit.Coefficients)
+			break
+		}
+	}
+	if math.Abs(fit.RSquared-1) > 1e-9 {
+		t.Errorf("Expected R² 1, got %v", fit.RSquared)
+	}
+	if s := (&Fit{Coefficients: []float64{1, -3, 2}}).String(); s != "y = 2x² - 3x + 1" {
+		t.Errorf("String() = %q", s)
+	}
+	if _, err := fitPolynomial([]float64{1, 1, 1}, []float64{1, 2, 3}, 1); err == nil {
+		t.Error("Expected an error for points with the same x.")
+	}
+	if _, err := fitPolynomial([]float64{1, 2}, []float64{1}, 1); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Expected ErrLengthMismatch, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package glot
+
+import "fmt"
+
+// FontTarget is the text of a plot a font is set for, see SetFont.
+type FontTarget int
+
+// The texts of a plot with their own font.
+const (
+	FontDefault FontTarget = iota // All text, set on the terminal the plot is saved with
+	FontTitle                     // The title of the plot
+	FontXLabel                    // The label of the x-axis
+	FontYLabel                    // The label of the y-axis
+	FontZLabel                    // The label of the z-axis
+	FontLegend                    // The entries of the legend
+	FontTics                      // The tic labels of all axes
+)
+
+// fontTargets maps the font targets to the gnuplot settings they change.
+var fontTargets = map[FontTarget]string{
+	FontTitle:  "title",
+	FontXLabel: "xlabel",
+	FontYLabel: "ylabel",
+	FontZLabel: "zlabel",
+	FontLegend: "key",
+	FontTics:   "tics",
+}
+
+// SetFont sets the font family and size in points of a text of the plot.
+// An empty family or a size of 0 keep the family or size of the default font.
+// The default font is used by the terminal when the plot is saved, the fonts
+// of the other targets apply right away.
+//
+// Usage
+//  plot.SetFont(glot.FontDefault, "Helvetica", 10)
+//  plot.SetFont(glot.FontTitle, "Helvetica Bold", 14)
+//  plot.SetFont(glot.FontTics, "", 8)
+func (plot *Plot) SetFont(target FontTarget, family string, size int) error {
+	font := fontSpec(family, size)
+	if target == FontDefault {
+		plot.mu.Lock()
+		defer plot.mu.Unlock()
+		plot.terminal.Font = font
+		return nil
+	}
+	element, exists := fontTargets[target]
+	if !exists {
+		return &gnuplotError{err: fmt.Sprintf("The font target %d is unknown.", target)}
+	}
+	return plot.set(element+" font", "set %s font %s", element, quote(font))
+}
+
+// fontSpec returns gnuplot's "family,size" notation of a font.
+func fontSpec(family string, size int) string {
+	if size <= 0 {
+		return family
+	}
+	return fmt.Sprintf("%s,%d", family, size)
+}
@@ -0,0 +1,22 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestSetFont(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetFont(FontDefault, "Helvetica", 10)
+	if plot.terminal.Font != "Helvetica,10" {
+		t.Error("Expected the terminal font to be set, got ", plot.terminal.Font)
+	}
+	plot.SetFont(FontTics, "", 8)
+	plot.SetFont(FontTitle, `My "Serif"`, 0)
+	if plot.options[0].cmd != `set tics font ",8"` || plot.options[1].cmd != `set title font "My \"Serif\""` {
+		t.Error("Unexpected commands ", plot.options)
+	}
+	if plot.SetFont(FontTarget(42), "Helvetica", 10) == nil {
+		t.Error("Expected an error for an unknown target")
+	}
+}
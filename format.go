@@ -0,0 +1,108 @@
+package glot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// timeLayoutTokens maps the elements of Go's time layouts to gnuplot's time format,
+// longest first so that "January" is not read as "Jan" and "uary".
+// gnuplot has no unpadded numbers, so "1" and "01" both become %m.
+var timeLayoutTokens = []struct {
+	layout string
+	format string
+}{
+	{"January", "%B"}, {"Monday", "%A"}, {"2006", "%Y"},
+	{"Jan", "%b"}, {"Mon", "%a"}, {"MST", ""},
+	{"-07:00", ""}, {"-0700", ""}, {"Z07:00", ""}, {"Z0700", ""},
+	{"15", "%H"}, {"01", "%m"}, {"02", "%d"}, {"_2", "%d"}, {"03", "%I"}, {"04", "%M"}, {"05", "%S"}, {"06", "%y"},
+	{"PM", "%p"}, {"pm", "%p"},
+	{"1", "%m"}, {"2", "%d"}, {"3", "%I"}, {"4", "%M"}, {"5", "%S"},
+	{"%", "%%"},
+}
+
+// timeFormat translates a Go time layout like "2006-01-02 15:04" to gnuplot's "%Y-%m-%d %H:%M".
+// Fractions of seconds like ".000" become a precision of %S, time zones are not supported.
+func timeFormat(layout string) (string, error) {
+	var format string
+	for len(layout) > 0 {
+		if strings.HasPrefix(layout, ".0") && strings.HasSuffix(format, "%S") {
+			digits := len(layout) - len(strings.TrimLeft(layout[1:], "0")) - 1
+			format = strings.TrimSuffix(format, "%S") + fmt.Sprintf("%%.%dS", digits)
+			layout = layout[1+digits:]
+			continue
+		}
+		matched := false
+		for _, token := range timeLayoutTokens {
+			if !strings.HasPrefix(layout, token.layout) {
+				continue
+			}
+			if token.format == "" {
+				return "", &gnuplotError{err: fmt.Sprintf("The time zone %s of the layout is not supported by gnuplot.", token.layout)}
+			}
+			format += token.format
+			layout = layout[len(token.layout):]
+			matched = true
+			break
+		}
+		if !matched {
+			format += layout[:1]
+			layout = layout[1:]
+		}
+	}
+	return format, nil
+}
+
+// SetXTimeFormat sets the format of the time tic labels of the x-axis with a Go time layout.
+//
+// Usage
+//  plot.AddPointGroup("Temperature", "lines", glot.TimeSeriesData{Time: times, Y: temperatures})
+//  plot.SetXTimeFormat("Jan 02 15:04")
+func (plot *Plot) SetXTimeFormat(layout string) error {
+	format, err := timeFormat(layout)
+	if err != nil {
+		return err
+	}
+	return plot.set("format x", "set format x %s timedate", quote(format))
+}
+
+// NumberFormat is a gnuplot format of the numbers of tic labels, see SetNumberFormat.
+// Any format of gnuplot's set format command can be used, like NumberFormat("%.2f").
+type NumberFormat string
+
+// Common number formats.
+const (
+	NumberDefault     NumberFormat = "% h"    // gnuplot's default
+	NumberEngineering NumberFormat = "%.1s%c" // With an SI prefix, like 1.5k and 20.0m
+	NumberScientific  NumberFormat = "%.1e"   // Like 1.5e+03
+	NumberThousands   NumberFormat = "%'.0f"  // With the thousands separator of the locale, see SetNumberLocale
+	NumberPercent     NumberFormat = "%.0f%%" // With a percent sign, for values in percent
+	NumberFixed2      NumberFormat = "%.2f"   // With two decimals
+	NumberInteger     NumberFormat = "%.0f"   // Rounded to whole numbers
+)
+
+// SetNumberFormat sets the format of the tic labels of an axis, one of x, y, z, x2, y2 and cb.
+//
+// Usage
+//  plot.SetNumberFormat("y", glot.NumberEngineering)
+//  plot.SetNumberFormat("x", glot.NumberFormat("%.1f ms"))
+func (plot *Plot) SetNumberFormat(axis string, format NumberFormat) error {
+	if !contains([]string{"x", "y", "z", "x2", "y2", "cb"}, axis) {
+		return &gnuplotError{err: fmt.Sprintf("The axis %s is not supported.", axis)}
+	}
+	return plot.set("format "+axis, "set format %s %s", axis, quote(string(format)))
+}
+
+// SetNumberLocale formats the numbers of the tic labels with the decimal sign and thousands
+// separator of the locale, like "de_DE.UTF-8", or of the environment of gnuplot when it is empty.
+// The locale must be installed where gnuplot runs.
+//
+// Usage
+//  plot.SetNumberLocale("en_US.UTF-8")
+//  plot.SetNumberFormat("y", glot.NumberThousands)
+func (plot *Plot) SetNumberLocale(locale string) error {
+	if locale == "" {
+		return plot.set("decimalsign", "set decimalsign locale")
+	}
+	return plot.set("decimalsign", "set decimalsign locale %s", quote(locale))
+}
@@ -0,0 +1,34 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestTimeFormat(t *testing.T) {
+	layouts := map[string]string{
+		"2006-01-02 15:04":    "%Y-%m-%d %H:%M",
+		"Jan 2, 3:04PM":       "%b %d, %I:%M%p",
+		"Monday 15:04:05.000": "%A %H:%M:%.3S",
+		"02/01/06 3pm":        "%d/%m/%y %I%p",
+	}
+	for layout, expected := range layouts {
+		if format, err := timeFormat(layout); err != nil || format != expected {
+			t.Errorf("Expected %q for %q, got %q %v", expected, layout, format, err)
+		}
+	}
+	if _, err := timeFormat("15:04 MST"); err == nil {
+		t.Error("Expected an error for a time zone")
+	}
+}
+
+func TestSetNumberFormat(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetNumberFormat("y", NumberPercent)
+	if plot.options[0].cmd != `set format y "%.0f%%"` {
+		t.Error("Unexpected command ", plot.options[0].cmd)
+	}
+	if plot.SetNumberFormat("w", NumberDefault) == nil {
+		t.Error("Expected an error for an unknown axis")
+	}
+}
@@ -69,7 +69,7 @@ func (plot *Plot) AddFunc2d(name string, style string, x []float64, fct Func2d)
 // NOTE: Currently only float64 type is supported for this function
 func (plot *Plot) AddFunc3d(name string, style string, x []float64, y []float64, fct Func3d) error {
 	if len(x) != len(y) {
-		return &gnuplotError{fmt.Sprintf("The length of the x-axis array and y-axis array are not same.")}
+		return &gnuplotError{err: fmt.Sprintf("The length of the x-axis array and y-axis array are not same.")}
 	}
 	z := make([]float64, len(x))
 	for index := range x {
@@ -82,3 +82,115 @@ func (plot *Plot) AddFunc3d(name string, style string, x []float64, y []float64,
 	plot.AddPointGroup(name, style, combined)
 	return nil
 }
+
+// FunctionData is a function of x written as a gnuplot expression, like "sin(x)/x",
+// that gnuplot samples itself between Min and Max.
+type FunctionData struct {
+	Expr    string
+	Min     float64
+	Max     float64
+	Samples int // Number of samples, gnuplot's default when 0
+}
+
+// AddFunction plots the gnuplot expression expr of x between rangeMin and rangeMax.
+// The expression is passed to gnuplot as it is, so any gnuplot function can be used.
+// gnuplot samples all functions of a plot the same number of times, so the last
+// number of samples given applies to every function.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddFunction("sinc", "sin(x)/x", -20, 20, 500)
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddFunction(name, expr string, rangeMin, rangeMax float64, samples int) error {
+	return plot.AddPointGroup(name, "lines", FunctionData{Expr: expr, Min: rangeMin, Max: rangeMax, Samples: samples})
+}
+
+func (plot *Plot) plotFunction(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(FunctionData)
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("Functions can only be plotted on 2-d plots."), kind: ErrInvalidDimensions}
+	}
+	if data.Expr == "" || data.Min >= data.Max {
+		return &gnuplotError{err: fmt.Sprintf("invalid function '%s' on [%v:%v]", data.Expr, data.Min, data.Max)}
+	}
+	if data.Samples > 0 {
+		cmd := fmt.Sprintf("set samples %d", data.Samples)
+		plot.keepSetting("samples", cmd)
+		err := plot.Cmd("%s", cmd)
+		if err != nil {
+			return err
+		}
+	}
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("plot [%v:%v] %s with %s", data.Min, data.Max, data.Expr, pointGroup.style)
+	} else {
+		line = fmt.Sprintf("plot [%v:%v] %s title %s with %s", data.Min, data.Max, data.Expr, plot.text(pointGroup.name), pointGroup.style)
+	}
+	return plot.sendPlotLine(pointGroup, line)
+}
+
+// Linspace returns n evenly spaced values from min to max, both included,
+// and nil for an n below 1.
+func Linspace(min, max float64, n int) []float64 {
+	if n <= 0 {
+		return nil
+	}
+	if n == 1 {
+		return []float64{min}
+	}
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = min + (max-min)*float64(i)/float64(n-1)
+	}
+	return values
+}
+
+// AddFunc samples the Go function f at n points from xmin to xmax and plots it as a line.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddFunc("Damped", func(x float64) float64 { return math.Exp(-x) * math.Cos(4*x) }, 0, 5, 200)
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddFunc(name string, f func(float64) float64, xmin, xmax float64, n int) error {
+	if n < 2 || xmin >= xmax {
+		return &gnuplotError{err: fmt.Sprintf("invalid sampling of %d points on [%v:%v]", n, xmin, xmax)}
+	}
+	x := Linspace(xmin, xmax, n)
+	y := make([]float64, n)
+	for i := range x {
+		y[i] = f(x[i])
+	}
+	return plot.AddPointGroup(name, "lines", [][]float64{x, y})
+}
+
+// AddFuncSurface samples the Go function f on a grid of n x n points over
+// [xmin:xmax] x [ymin:ymax] and plots it as a surface on a 3-d plot.
+//
+// Usage
+//  dimensions := 3
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddFuncSurface("Saddle", func(x, y float64) float64 { return x*x - y*y }, -1, 1, -1, 1, 40)
+func (plot *Plot) AddFuncSurface(name string, f func(x, y float64) float64, xmin, xmax, ymin, ymax float64, n int) error {
+	if n < 2 || xmin >= xmax || ymin >= ymax {
+		return &gnuplotError{err: fmt.Sprintf("invalid sampling of %dx%d points on [%v:%v]x[%v:%v]", n, n, xmin, xmax, ymin, ymax)}
+	}
+	xs := Linspace(xmin, xmax, n)
+	ys := Linspace(ymin, ymax, n)
+	z := make([][]float64, n)
+	for i, y := range ys {
+		z[i] = make([]float64, n)
+		for j, x := range xs {
+			z[i][j] = f(x, y)
+		}
+	}
+	return plot.AddSurface(name, xs, ys, z)
+}
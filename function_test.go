@@ -1,6 +1,7 @@
 package glot
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -19,3 +20,42 @@ func TestAddFunc3d(t *testing.T) {
 		t.Error("TestAddFunc3d raises error when the size of X and Y arrays are not equal.")
 	}
 }
+
+func TestAddFunction(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	plot.AddPointGroup("Sample", "points", [][]float64{{1, 2}, {2, 3}})
+	err := plot.AddFunction("sinc", "sin(x)/x", -20, 20, 500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := plot.history[len(plot.history)-1]
+	if !strings.HasSuffix(last, `, [-20:20] sin(x)/x title "sinc" with lines lc rgb "#f28e2b"`) {
+		t.Error("Expected the function to be drawn with the plot, got ", last)
+	}
+}
+
+func TestAddFunc(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddFunc("Square", func(x float64) float64 { return x * x }, 0, 1, 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := plot.PointGroup["Square"].castedData.([][]float64)
+	if len(data[0]) != 11 || data[1][10] != 1 {
+		t.Error("Unexpected samples ", data)
+	}
+}
+
+func TestLinspace(t *testing.T) {
+	if values := Linspace(0, 1, 5); len(values) != 5 || values[0] != 0 || values[2] != 0.5 || values[4] != 1 {
+		t.Error("Unexpected values ", values)
+	}
+	if values := Linspace(3, 4, 1); len(values) != 1 || values[0] != 3 {
+		t.Error("Expected only the minimum, got ", values)
+	}
+	for _, n := range []int{0, -1} {
+		if values := Linspace(0, 1, n); values != nil {
+			t.Errorf("Expected no values for n = %d, got %v", n, values)
+		}
+	}
+}
@@ -8,9 +8,15 @@
 package glot
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Plot is the basic type representing a plot.
@@ -22,6 +28,8 @@ import (
 // the time of plot construction.
 // The Pointgroups can be dynamically added and removed from a plot
 // And style changes can also be made dynamically.
+// The methods of a Plot are safe for concurrent use, but the PointGroup map
+// must not be accessed directly while other goroutines modify the plot.
 type Plot struct {
 	proc       *plotterProcess
 	debug      bool
@@ -33,6 +41,40 @@ type Plot struct {
 	format     string                 // The saving format of the plot. This could be PDF, PNG, JPEG and so on.
 	style      string                 // style of the plot
 	title      string                 // The title of the plot.
+	refresh    chan struct{}          // Stops the refresh loop of a live plot
+	ctx        context.Context        // Context bounding the lifetime of the gnuplot process
+	history    []string               // Every command sent to gnuplot, in order
+	mu         sync.Mutex             // Guards PointGroup, nplots, tmpfiles and refresh
+	cmdMu      sync.Mutex             // Serializes the commands sent to gnuplot and guards history
+	pool       *PlotterPool           // The pool the gnuplot process is returned to on Close, if any
+	scriptMode bool                   // Commands are kept in pending until Flush is called
+	pending    bytes.Buffer           // Commands that are not sent to gnuplot yet
+	binary     bool                   // Write the data of numeric PointGroups in binary format
+	maxPoints  int                    // Number of points numeric PointGroups are reduced to, 0 for all
+	sampling   Downsampling           // Strategy used to reduce the number of points
+	terminal   TerminalOptions        // Options of the terminal the plot is saved with
+	screen     string                 // Interactive terminal the plot is shown with, if set
+	closed     bool                   // Close was called
+	tempDir    string                 // Directory of the data files, os.TempDir() when empty
+	config     Settings               // Title, labels and ranges, see Settings
+	options    []setting              // Settings sent to gnuplot again after a reset
+	tags       int                    // Last tag used for a gnuplot object or label
+	noRestart  bool                   // Don't restart gnuplot when it died, guarded by cmdMu
+	passthru   bool                   // Send unknown styles to gnuplot as they are
+	colors     []string               // Colors given in turn to the PointGroups without a color
+	background int                    // Tag of the background rectangle of the theme, 0 when there is none
+	literal    bool                   // Escape the enhanced text markup of titles, labels and names
+	insets     []inset                // Plots drawn over the graph when the plot is saved
+	winTitle   string                 // Title of the window of the interactive terminal
+	persist    string                 // persist or nopersist option of the interactive terminal, if set
+	logger     atomic.Value           // loggerBox with the Logger of the plot
+	dryRun     bool                   // Commands are only recorded, there is no gnuplot process
+	plotter    Plotter                // Receives the commands instead of proc, see WithPlotter
+	stable     bool                   // Deterministic output, see WithDeterministic
+	files      int                    // Number of data files of a deterministic plot or datablocks
+	inline     bool                   // Send the data as datablocks instead of files
+	blocks     map[string][]byte      // Content of the datablocks by their names
+	gnuplot    string                 // gnuplot binary of the plot, whose version gates features
 }
 
 // NewPlot Function makes a new plot with the specified dimensions.
@@ -47,33 +89,176 @@ type Plot struct {
 //  debug       :=> can be used by developers to check the actual commands sent to gnu plot.
 //  persist     :=> used to make the gnu plot window stay open.
 func NewPlot(dimensions int, persist, debug bool) (*Plot, error) {
-	p := &Plot{proc: nil, debug: debug, plotcmd: "plot",
-		nplots: 0, dimensions: dimensions, style: "points", format: "png"}
-	p.PointGroup = make(map[string]*PointGroup) // Adding a mapping between a curve name and a curve
-	p.tmpfiles = make(tmpfilesDb)
-	proc, err := newPlotterProc(persist)
+	return NewPlotContext(context.Background(), dimensions, persist, debug)
+}
+
+// NewPlotContext is like NewPlot but bounds the plot by a context.
+// When the context is cancelled or times out the gnuplot process is killed
+// and every following command returns the error of the context.
+//
+// Usage
+//  ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//  defer cancel()
+//  plot, _ := glot.NewPlotContext(ctx, 2, false, false)
+func NewPlotContext(ctx context.Context, dimensions int, persist, debug bool) (*Plot, error) {
+	p, err := newPlot(ctx, dimensions, debug)
+	if err != nil {
+		return nil, err
+	}
+	proc, err := newPlotterProc(ctx, gGnuplotCmd, persist)
 	if err != nil {
 		return nil, err
 	}
+	p.proc = proc
+	p.setFinalizer()
+	return p, nil
+}
+
+// newPlot makes a plot without a gnuplot process.
+func newPlot(ctx context.Context, dimensions int, debug bool) (*Plot, error) {
+	p := &Plot{proc: nil, debug: debug, plotcmd: "plot",
+		nplots: 0, dimensions: dimensions, style: "points", format: "png", ctx: ctx, colors: ColorCycleTableau10, gnuplot: gGnuplotCmd}
+	p.PointGroup = make(map[string]*PointGroup) // Adding a mapping between a curve name and a curve
+	p.tmpfiles = make(tmpfilesDb)
+	p.blocks = make(map[string][]byte)
+	p.logger.Store(loggerBox{debugLogger(debug)})
 	// Only 1,2,3 Dimensional plots are supported
 	if dimensions > 3 || dimensions < 1 {
-		return nil, &gnuplotError{fmt.Sprintf("invalid number of dims '%v'", dimensions)}
+		return nil, &gnuplotError{err: fmt.Sprintf("invalid number of dims '%v'", dimensions), kind: ErrInvalidDimensions}
 	}
-	p.proc = proc
 	return p, nil
 }
 
+// newRecordingPlot makes a plot without a gnuplot process that only records its commands,
+// for plots that are drawn as part of another one, like insets and frames of animations.
+func newRecordingPlot(ctx context.Context, dimensions int, debug bool) (*Plot, error) {
+	p, err := newPlot(ctx, dimensions, debug)
+	if err != nil {
+		return nil, err
+	}
+	p.scriptMode = true
+	return p, nil
+}
+
+// SetTempDir sets the directory the data files of the PointGroups added afterwards are written to,
+// for instance a tmpfs mount, or a directory to inspect the files in while debugging.
+// The directory must exist. An empty dir selects the default directory for temporary files.
+//
+// Usage
+//  plot.SetTempDir("/dev/shm")
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+func (plot *Plot) SetTempDir(dir string) error {
+	if dir != "" {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return &gnuplotError{err: fmt.Sprintf("%s is not a directory", dir)}
+		}
+	}
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.tempDir = dir
+	return nil
+}
+
+// tempFile creates a data file in the directory of the plot.
+func (plot *Plot) tempFile() (*os.File, error) {
+	dir := plot.tempDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	if plot.stable {
+		return plot.stableFile(dir)
+	}
+	return ioutil.TempFile(dir, gGnuplotPrefix)
+}
+
+// dataFile returns the data file of a PointGroup, opened for writing.
+// The temporary file of a PointGroup that was plotted before is truncated and reused,
+// otherwise a new one is created and kept on the plot.
+func (plot *Plot) dataFile(pointGroup *PointGroup) (dataWriter, error) {
+	if pointGroup.source != nil {
+		return sharedData(pointGroup.fname), nil
+	}
+	if _, owned := plot.blocks[pointGroup.fname]; owned {
+		return &datablock{plot: plot, name: pointGroup.fname}, nil
+	}
+	if plot.inline {
+		return plot.newBlock(pointGroup), nil
+	}
+	if _, owned := plot.tmpfiles[pointGroup.fname]; owned {
+		return os.OpenFile(pointGroup.fname, os.O_WRONLY|os.O_TRUNC, 0600)
+	}
+	f, err := plot.tempFile()
+	if err != nil {
+		return nil, err
+	}
+	plot.tmpfiles[f.Name()] = f
+	pointGroup.fname = f.Name()
+	plot.log(LogDebug, "data file created", "file", f.Name(), "pointgroup", pointGroup.name)
+	return f, nil
+}
+
+// sendPlotLine sends the plot command of a PointGroup to gnuplot.
+// The command without its leading plot/splot/replot is kept on the PointGroup
+// so that it can be drawn again in a single plot command, e.g. by a Figure.
+// A PointGroup of the plot that is plotted again, e.g. with another style,
+// keeps its place and the whole plot is drawn again.
+// So is a PointGroup with a sampling range, which replot doesn't accept.
+func (plot *Plot) sendPlotLine(pointGroup *PointGroup, line string) error {
+	parts := strings.SplitN(line, " ", 2)
+	return plot.sendPlotSpecs(pointGroup, parts[0], parts[1])
+}
+
+// sendPlotSpecs is sendPlotLine for the PointGroups drawn by several specs,
+// like the groups of a boxplot, so that the appearance of the PointGroup applies to each of them.
+func (plot *Plot) sendPlotSpecs(pointGroup *PointGroup, cmd string, specs ...string) error {
+	plotted := len(pointGroup.specs) > 0
+	pointGroup.specs = specs
+	if plotted && plot.PointGroup[pointGroup.name] == pointGroup {
+		return plot.Cmd("%s", plot.plotAllCmd())
+	}
+	pointGroup.index = plot.nplots
+	plot.nplots++
+	if strings.HasPrefix(specs[0], "[") {
+		plot.PointGroup[pointGroup.name] = pointGroup
+		return plot.Cmd("%s", plot.plotAllCmd())
+	}
+	return plot.Cmd("%s %s", cmd, pointGroup.plotSpec())
+}
+
+// redraw draws all PointGroups again with a single plot command,
+// without writing their data files again.
+func (plot *Plot) redraw() error {
+	if plot.empty() {
+		return nil
+	}
+	return plot.Cmd("%s", plot.plotAll())
+}
+
 func (plot *Plot) plotX(pointGroup *PointGroup) error {
-	f, err := ioutil.TempFile(os.TempDir(), gGnuplotPrefix)
+	f, err := plot.dataFile(pointGroup)
 	if err != nil {
 		return err
 	}
 	fname := f.Name()
-	plot.tmpfiles[fname] = f
-	for _, d := range pointGroup.castedData.([]float64) {
-		f.WriteString(fmt.Sprintf("%v\n", d))
+	// The index is written as x, so that downsampling keeps the x of the points it keeps.
+	y := pointGroup.castedData.([]float64)
+	data := downsample(pointGroup.smoothed([][]float64{indexes(len(y)), y}), plot.maxPoints, plot.sampling)
+	file := quote(fname)
+	if plot.binaryData(pointGroup) {
+		pointGroup.binary = true
+		file += " " + binaryFormat(2)
+		err = writeBinary(f, data...)
+	} else {
+		err = writeText(f, data...)
 	}
 	f.Close()
+	if err != nil {
+		return err
+	}
 	cmd := plot.plotcmd
 	if plot.nplots > 0 {
 		cmd = plotCommand
@@ -83,37 +268,53 @@ func (plot *Plot) plotX(pointGroup *PointGroup) error {
 	}
 	var line string
 	if pointGroup.name == "" {
-		line = fmt.Sprintf("%s \"%s\" with %s", cmd, fname, pointGroup.style)
+		line = fmt.Sprintf("%s %s using 1:2 with %s", cmd, file, pointGroup.style)
 	} else {
-		line = fmt.Sprintf("%s \"%s\" title \"%s\" with %s",
-			cmd, fname, pointGroup.name, pointGroup.style)
+		line = fmt.Sprintf("%s %s using 1:2 title %s with %s",
+			cmd, file, plot.text(pointGroup.name), pointGroup.style)
 	}
 
 	if pointGroup.pointSize > 0 {
 		line = fmt.Sprintf(`%s pt %d ps %.2f`, line, pointGroup.pointType, pointGroup.pointSize)
 	}
 
-	plot.nplots++
-	return plot.Cmd(line)
+	return plot.sendPlotLine(pointGroup, line)
+}
+
+// indexes returns the indexes 0 to n-1, the x of 1-d points.
+func indexes(n int) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	return x
 }
 
 func (plot *Plot) plotXY(pointGroup *PointGroup) error {
-	x := pointGroup.castedData.([][]float64)[0]
-	y := pointGroup.castedData.([][]float64)[1]
+	data := downsample(pointGroup.smoothed(pointGroup.castedData.([][]float64)), plot.maxPoints, plot.sampling)
+	x := data[0]
+	y := data[1]
 	npoints := min(len(x), len(y))
 
-	f, err := ioutil.TempFile(os.TempDir(), gGnuplotPrefix)
+	f, err := plot.dataFile(pointGroup)
 	if err != nil {
 		return err
 	}
 	fname := f.Name()
-	plot.tmpfiles[fname] = f
 
-	for i := 0; i < npoints; i++ {
-		f.WriteString(fmt.Sprintf("%v %v\n", x[i], y[i]))
+	file := quote(fname)
+	if plot.binaryData(pointGroup) {
+		pointGroup.binary = true
+		file += " " + binaryFormat(2)
+		err = writeBinary(f, x[:npoints], y[:npoints])
+	} else {
+		err = writeText(f, x[:npoints], y[:npoints])
 	}
 
 	f.Close()
+	if err != nil {
+		return err
+	}
 	cmd := plot.plotcmd
 	if plot.nplots > 0 {
 		cmd = plotCommand
@@ -124,38 +325,45 @@ func (plot *Plot) plotXY(pointGroup *PointGroup) error {
 	}
 	var line string
 	if pointGroup.name == "" {
-		line = fmt.Sprintf("%s \"%s\" with %s", cmd, fname, pointGroup.style)
+		line = fmt.Sprintf("%s %s with %s", cmd, file, pointGroup.style)
 	} else {
-		line = fmt.Sprintf("%s \"%s\" title \"%s\" with %s",
-			cmd, fname, pointGroup.name, pointGroup.style)
+		line = fmt.Sprintf("%s %s title %s with %s",
+			cmd, file, plot.text(pointGroup.name), pointGroup.style)
 	}
 
 	if pointGroup.pointSize > 0 {
 		line = fmt.Sprintf(`%s pt %d ps %.2f`, line, pointGroup.pointType, pointGroup.pointSize)
 	}
 
-	plot.nplots++
-	return plot.Cmd(line)
+	return plot.sendPlotLine(pointGroup, line)
 }
 
 func (plot *Plot) plotXYZ(pointGroup *PointGroup) error {
-	x := pointGroup.castedData.([][]float64)[0]
-	y := pointGroup.castedData.([][]float64)[1]
-	z := pointGroup.castedData.([][]float64)[2]
+	data := downsample(pointGroup.castedData.([][]float64), plot.maxPoints, plot.sampling)
+	x := data[0]
+	y := data[1]
+	z := data[2]
 	npointGroup := min(len(x), len(y))
 	npointGroup = min(npointGroup, len(z))
-	f, err := ioutil.TempFile(os.TempDir(), gGnuplotPrefix)
+	f, err := plot.dataFile(pointGroup)
 	if err != nil {
 		return err
 	}
 	fname := f.Name()
-	plot.tmpfiles[fname] = f
 
-	for i := 0; i < npointGroup; i++ {
-		f.WriteString(fmt.Sprintf("%v %v %v\n", x[i], y[i], z[i]))
+	file := quote(fname)
+	if plot.binaryData(pointGroup) {
+		pointGroup.binary = true
+		file += " " + binaryFormat(3)
+		err = writeBinary(f, x[:npointGroup], y[:npointGroup], z[:npointGroup])
+	} else {
+		err = writeText(f, x[:npointGroup], y[:npointGroup], z[:npointGroup])
 	}
 
 	f.Close()
+	if err != nil {
+		return err
+	}
 	cmd := "splot" // Force 3D plot
 	if plot.nplots > 0 {
 		cmd = plotCommand
@@ -163,37 +371,55 @@ func (plot *Plot) plotXYZ(pointGroup *PointGroup) error {
 
 	var line string
 	if pointGroup.name == "" {
-		line = fmt.Sprintf("%s \"%s\" with %s", cmd, fname, pointGroup.style)
+		line = fmt.Sprintf("%s %s with %s", cmd, file, pointGroup.style)
 	} else {
-		line = fmt.Sprintf("%s \"%s\" title \"%s\" with %s",
-			cmd, fname, pointGroup.name, pointGroup.style)
+		line = fmt.Sprintf("%s %s title %s with %s",
+			cmd, file, plot.text(pointGroup.name), pointGroup.style)
 	}
 
 	if pointGroup.pointSize > 0 {
 		line = fmt.Sprintf(`%s pt %d ps %.2f`, line, pointGroup.pointType, pointGroup.pointSize)
 	}
 
-	plot.nplots++
-	return plot.Cmd(line)
+	return plot.sendPlotLine(pointGroup, line)
 }
 
 func (plot *Plot) plotCandlesticks(PointGroup *PointGroup) error {
 	data := PointGroup.castedData.(CandlesticksData)
 	nCandles := len(data.XArray)
+	if len(data.Timestamps) > 0 {
+		nCandles = len(data.Timestamps)
+	}
+	if len(data.Candles) != nCandles || (len(data.Volume) > 0 && len(data.Volume) != nCandles) {
+		return &gnuplotError{err: fmt.Sprintf("The number of candles, volumes and x values are not the same.")}
+	}
 
-	f, err := ioutil.TempFile(os.TempDir(), gGnuplotPrefix)
+	f, err := plot.dataFile(PointGroup)
 	if err != nil {
 		return err
 	}
 	fname := f.Name()
-	plot.tmpfiles[fname] = f
 
+	maxVolume := 0.0
 	for i := 0; i < nCandles; i++ {
-		f.WriteString(fmt.Sprintf("%v %v %v %v %v\n", data.XArray[i], data.Candles[i][0], data.Candles[i][1], data.Candles[i][2], data.Candles[i][3]))
+		var x int64
+		if len(data.Timestamps) > 0 {
+			x = data.Timestamps[i]
+		} else {
+			x = data.XArray[i]
+		}
+		line := fmt.Sprintf("%v %v %v %v %v", x, data.Candles[i][0], data.Candles[i][1], data.Candles[i][2], data.Candles[i][3])
+		if len(data.Volume) > 0 {
+			line += fmt.Sprintf(" %v", data.Volume[i])
+			if data.Volume[i] > maxVolume {
+				maxVolume = data.Volume[i]
+			}
+		}
+		f.WriteString(line + "\n")
 	}
 	f.Close()
 
-	err = plot.Cmd(fmt.Sprintf(`set palette defined (-1 '%s', 1 '%s')`, data.DownColor, data.UpColor))
+	err = plot.Cmd("set palette defined (-1 %s, 1 %s)", quote(data.DownColor), quote(data.UpColor))
 	if err != nil {
 		return err
 	}
@@ -205,7 +431,11 @@ func (plot *Plot) plotCandlesticks(PointGroup *PointGroup) error {
 	if err != nil {
 		return err
 	}
-	err = plot.Cmd(`set style fill solid noborder`)
+	if data.BorderColor != "" {
+		err = plot.Cmd("set style fill solid border rgb %s", quote(data.BorderColor))
+	} else {
+		err = plot.Cmd(`set style fill solid noborder`)
+	}
 	if err != nil {
 		return err
 	}
@@ -213,6 +443,23 @@ func (plot *Plot) plotCandlesticks(PointGroup *PointGroup) error {
 	if err != nil {
 		return err
 	}
+	if len(data.Timestamps) > 0 {
+		timeFormat := data.TimeFormat
+		if timeFormat == "" {
+			timeFormat = "%Y-%m-%d"
+		}
+		err = plot.Cmd("set xdata time\nset timefmt \"%%s\"\nset format x %s", quote(timeFormat))
+		if err != nil {
+			return err
+		}
+	}
+	if maxVolume > 0 {
+		// Scale the y2-axis so that the tallest bar fills the lower quarter of the graph.
+		err = plot.Cmd("set y2range [0:%v]\nunset y2tics", 4*maxVolume)
+		if err != nil {
+			return err
+		}
+	}
 
 	cmd := plot.plotcmd
 	if plot.nplots > 0 {
@@ -222,13 +469,357 @@ func (plot *Plot) plotCandlesticks(PointGroup *PointGroup) error {
 	if PointGroup.style == "" {
 		PointGroup.style = "candlesticks"
 	}
-	var line string
+	var specs []string
+	if maxVolume > 0 {
+		volumeColor := data.VolumeColor
+		if volumeColor == "" {
+			volumeColor = "#c0c0c0"
+		}
+		specs = append(specs, fmt.Sprintf("%s using 1:6 axes x1y2 notitle with boxes lc rgb %s", quote(fname), quote(volumeColor)))
+	}
+	if data.WickColor != "" && PointGroup.style != "financebars" {
+		// Draw the wicks first, so that the bodies are drawn over them.
+		specs = append(specs, fmt.Sprintf("%s using 1:4:(0):($3-$4) notitle with vectors nohead lc rgb %s", quote(fname), quote(data.WickColor)))
+	}
 	if PointGroup.name == "" {
-		line = fmt.Sprintf("%s \"%s\" using 1:2:4:3:5:($5 < $2 ? -1 : 1) with %s palette", cmd, fname, PointGroup.style)
+		specs = append(specs, fmt.Sprintf("%s using 1:2:4:3:5:($5 < $2 ? -1 : 1) with %s palette", quote(fname), PointGroup.style))
 	} else {
-		line = fmt.Sprintf("%s \"%s\" using 1:2:4:3:5:($5 < $2 ? -1 : 1) title \"%s\" with %s palette",
-			cmd, fname, PointGroup.name, PointGroup.style)
+		specs = append(specs, fmt.Sprintf("%s using 1:2:4:3:5:($5 < $2 ? -1 : 1) title %s with %s palette",
+			quote(fname), plot.text(PointGroup.name), PointGroup.style))
 	}
-	plot.nplots++
-	return plot.Cmd(line)
+	return plot.sendPlotSpecs(PointGroup, cmd, specs...)
+}
+
+// plotFinanceBars plots CandlesticksData as OHLC bars, with a tick to the left for the open price
+// and a tick to the right for the close price. The length of the ticks is set by "set errorbars".
+func (plot *Plot) plotFinanceBars(pointGroup *PointGroup) error {
+	pointGroup.style = "financebars"
+	return plot.plotCandlesticks(pointGroup)
+}
+
+func (plot *Plot) plotHeatmap(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(HeatmapData)
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+
+	for _, row := range data.Matrix {
+		for j, v := range row {
+			if j > 0 {
+				f.WriteString(" ")
+			}
+			f.WriteString(fmt.Sprintf("%v", v))
+		}
+		f.WriteString("\n")
+	}
+	f.Close()
+
+	if data.Palette != "" {
+		err = plot.Cmd("set palette %s", data.Palette)
+		if err != nil {
+			return err
+		}
+	}
+	if data.CBMin != data.CBMax {
+		err = plot.Cmd("set cbrange [%v:%v]", data.CBMin, data.CBMax)
+		if err != nil {
+			return err
+		}
+	}
+
+	// A heatmap is drawn as an image on 2D plots and as a pm3d surface on 3D plots.
+	cmd := plot.plotcmd
+	with := "image"
+	if plot.dimensions == 3 {
+		cmd = "splot"
+		with = "pm3d"
+	}
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("%s %s matrix with %s", cmd, quote(fname), with)
+	} else {
+		line = fmt.Sprintf("%s %s matrix title %s with %s",
+			cmd, quote(fname), plot.text(pointGroup.name), with)
+	}
+	return plot.sendPlotLine(pointGroup, line)
+}
+
+func (plot *Plot) plotErrorBars(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(ErrorBarData)
+	if len(data.X) != len(data.Y) {
+		return &gnuplotError{err: fmt.Sprintf("The length of the x-axis array and y-axis array are not same.")}
+	}
+
+	style := pointGroup.style
+	if style != "xerrorbars" && style != "xyerrorbars" {
+		style = "yerrorbars"
+	}
+	columns := [][]float64{data.X, data.Y}
+	if style == "xerrorbars" || style == "xyerrorbars" {
+		columns = append(columns, data.XErr)
+	}
+	if style == "yerrorbars" || style == "xyerrorbars" {
+		if data.YLow != nil || data.YHigh != nil {
+			columns = append(columns, data.YLow, data.YHigh)
+		} else {
+			columns = append(columns, data.YErr)
+		}
+	}
+	// gnuplot only accepts x xdelta ydelta or x xlow xhigh ylow yhigh for xyerrorbars.
+	if style == "xyerrorbars" && len(columns) == 5 {
+		return &gnuplotError{err: fmt.Sprintf("xyerrorbars requires XErr and YErr, asymmetric y errors are not supported.")}
+	}
+	for _, column := range columns[2:] {
+		if len(column) != len(data.X) {
+			return &gnuplotError{err: fmt.Sprintf("The length of the error arrays must match the length of the x-axis array.")}
+		}
+	}
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+
+	using := "1"
+	for i := 2; i <= len(columns); i++ {
+		using = fmt.Sprintf("%s:%d", using, i)
+	}
+	err = writeText(f, columns...)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	pointGroup.style = style
+
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("%s %s using %s with %s", cmd, quote(fname), using, pointGroup.style)
+	} else {
+		line = fmt.Sprintf("%s %s using %s title %s with %s",
+			cmd, quote(fname), using, plot.text(pointGroup.name), pointGroup.style)
+	}
+
+	if pointGroup.pointSize > 0 {
+		line = fmt.Sprintf(`%s pt %d ps %.2f`, line, pointGroup.pointType, pointGroup.pointSize)
+	}
+
+	return plot.sendPlotLine(pointGroup, line)
+}
+
+func (plot *Plot) plotHistogram(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(HistogramData)
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+
+	err = writeText(f, data.X, data.Y, data.Width)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	if pointGroup.style == defaultStyle {
+		pointGroup.style = "boxes"
+	}
+
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("%s %s using 1:2:3 with %s", cmd, quote(fname), pointGroup.style)
+	} else {
+		line = fmt.Sprintf("%s %s using 1:2:3 title %s with %s",
+			cmd, quote(fname), plot.text(pointGroup.name), pointGroup.style)
+	}
+	return plot.sendPlotLine(pointGroup, line)
+}
+
+// timeLayout is the layout times are written with to the data files.
+// It has no spaces, so every time stays a single column without quoting.
+const timeLayout = "2006-01-02T15:04:05"
+const timeFmt = "%Y-%m-%dT%H:%M:%S"
+
+func (plot *Plot) plotTimeSeries(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(TimeSeriesData)
+	times := data.Time
+	if times == nil {
+		times = make([]time.Time, len(data.Unix))
+		for i, sec := range data.Unix {
+			times[i] = time.Unix(sec, 0)
+		}
+	}
+	if len(times) != len(data.Y) {
+		return &gnuplotError{err: fmt.Sprintf("The length of the x-axis array and y-axis array are not same.")}
+	}
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+
+	for i := range times {
+		f.WriteString(fmt.Sprintf("%s %v\n", times[i].Format(timeLayout), data.Y[i]))
+	}
+	f.Close()
+
+	format := data.Format
+	if format == "" {
+		format = "%Y-%m-%d %H:%M"
+	}
+	err = plot.Cmd("set xdata time")
+	if err != nil {
+		return err
+	}
+	err = plot.Cmd("set timefmt \"%s\"", timeFmt)
+	if err != nil {
+		return err
+	}
+	// The format set by SetXTimeFormat is kept unless the data has its own.
+	if data.Format != "" || !plot.hasSetting("format x") {
+		err = plot.Cmd("set format x %s", quote(format))
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("%s %s using 1:2 with %s", cmd, quote(fname), pointGroup.style)
+	} else {
+		line = fmt.Sprintf("%s %s using 1:2 title %s with %s",
+			cmd, quote(fname), plot.text(pointGroup.name), pointGroup.style)
+	}
+
+	if pointGroup.pointSize > 0 {
+		line = fmt.Sprintf(`%s pt %d ps %.2f`, line, pointGroup.pointType, pointGroup.pointSize)
+	}
+
+	return plot.sendPlotLine(pointGroup, line)
+}
+
+func (plot *Plot) plotBoxPlot(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(BoxPlotData)
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+
+	// Every group is a separate data block, selected with index in the plot command.
+	for i, group := range data.Groups {
+		if i > 0 {
+			f.WriteString("\n\n")
+		}
+		for _, v := range group {
+			f.WriteString(fmt.Sprintf("%v\n", v))
+		}
+	}
+	f.Close()
+
+	whiskerRange := data.WhiskerRange
+	if whiskerRange == 0 {
+		whiskerRange = 1.5
+	}
+	outliers := "outliers"
+	if data.HideOutliers {
+		outliers = "nooutliers"
+	}
+	err = plot.Cmd("set style boxplot range %v %s", whiskerRange, outliers)
+	if err != nil {
+		return err
+	}
+	if data.Labels != nil {
+		tics := make([]string, len(data.Labels))
+		for i, label := range data.Labels {
+			tics[i] = fmt.Sprintf("%s %d", plot.text(label), i+1)
+		}
+		err = plot.Cmd("set xtics (%s)", strings.Join(tics, ", "))
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	pointGroup.style = "boxplot"
+
+	specs := make([]string, len(data.Groups))
+	for i := range data.Groups {
+		title := "notitle"
+		if i == 0 && pointGroup.name != "" {
+			title = fmt.Sprintf("title %s", plot.text(pointGroup.name))
+		}
+		specs[i] = fmt.Sprintf("%s index %d using (%d):1 %s with %s", quote(fname), i, i+1, title, pointGroup.style)
+	}
+	return plot.sendPlotSpecs(pointGroup, cmd, specs...)
+}
+
+func (plot *Plot) plotSurface(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(SurfaceData)
+	if len(data.Z) != len(data.Y) {
+		return &gnuplotError{err: fmt.Sprintf("The number of rows of the z matrix must match the length of the y-axis array."), kind: ErrInvalidDimensions}
+	}
+	for _, row := range data.Z {
+		if len(row) != len(data.X) {
+			return &gnuplotError{err: fmt.Sprintf("The number of columns of the z matrix must match the length of the x-axis array."), kind: ErrInvalidDimensions}
+		}
+	}
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+
+	// gnuplot reads every block separated by a blank line as one scan line of the grid.
+	for i, row := range data.Z {
+		if i > 0 {
+			f.WriteString("\n")
+		}
+		for j, z := range row {
+			f.WriteString(fmt.Sprintf("%v %v %v\n", data.X[j], data.Y[i], z))
+		}
+	}
+	f.Close()
+
+	cmd := "splot"
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("%s %s with %s", cmd, quote(fname), pointGroup.style)
+	} else {
+		line = fmt.Sprintf("%s %s title %s with %s",
+			cmd, quote(fname), plot.text(pointGroup.name), pointGroup.style)
+	}
+	return plot.sendPlotLine(pointGroup, line)
 }
@@ -1,6 +1,12 @@
 package glot
 
-import "testing"
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
 
 func TestNewPlot(t *testing.T) {
 	persist := false
@@ -10,3 +16,26 @@ func TestNewPlot(t *testing.T) {
 		t.Error("Expected error when making a 0 dimensional plot.")
 	}
 }
+
+func TestNewPlotContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := NewPlotContext(ctx, 2, false, false)
+	if err == nil {
+		t.Error("Expected error when making a plot with a cancelled context.")
+	}
+}
+
+func TestSetTempDir(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "glot-tmp-")
+	defer os.RemoveAll(dir)
+	plot, _ := NewPlot(1, false, false)
+	if err := plot.SetTempDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	plot.AddPointGroup("Sample1", "points", []float64{1, 2, 4, 11})
+	if filepath.Dir(plot.PointGroup["Sample1"].fname) != dir {
+		t.Error("Expected the data file in ", dir)
+	}
+	plot.Close()
+}
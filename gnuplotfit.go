@@ -0,0 +1,149 @@
+package glot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FitResult holds the parameters fitted by gnuplot's fit command, see Fit.
+type FitResult struct {
+	Params map[string]float64 // Fitted values of the parameters
+	Errors map[string]float64 // Asymptotic standard errors of the parameters
+	StdFit float64            // Root mean square of the residuals, gnuplot's FIT_STDFIT
+	NDF    int                // Degrees of freedom, the number of points minus the number of parameters
+}
+
+// fitMarker starts the lines with the results of a fit printed by gnuplot.
+const fitMarker = "glot-fit "
+
+// identifier matches the names gnuplot accepts for variables.
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Fit fits the expression of x to the data of a 1-d or 2-d PointGroup with gnuplot's fit command,
+// by the nonlinear least squares of Marquardt-Levenberg, and returns the fitted parameters.
+// The params name the parameters of the expression with their initial values, which
+// matter for nonlinear expressions. The fit runs in a gnuplot process of its own,
+// nothing is drawn; plot the result with AddFunction.
+//
+// Usage
+//  samples, _ := plot.Add("Decay", "points", [][]float64{t, counts})
+//  result, err := plot.Fit("a*exp(-b*x)", samples, map[string]float64{"a": 100, "b": 0.1})
+//  if err == nil {
+//  	expr := fmt.Sprintf("%v*exp(-%v*x)", result.Params["a"], result.Params["b"])
+//  	plot.AddFunction("Fit", expr, 0, 10, 100)
+//  }
+func (plot *Plot) Fit(expr string, data *PointGroup, params map[string]float64) (*FitResult, error) {
+	if len(params) == 0 {
+		return nil, &gnuplotError{err: "The fit has no parameters."}
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		if !identifier.MatchString(name) {
+			return nil, &gnuplotError{err: fmt.Sprintf("The parameter name %q is not a gnuplot variable.", name)}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	err := plot.requireFeature("fit")
+	if err != nil {
+		return nil, err
+	}
+
+	source, _, commands, err := plot.dataSource(data)
+	if err != nil {
+		return nil, err
+	}
+	// The data files of 1-d points have their index as x, like those of 2-d points.
+	using := "1:2"
+
+	commands = append(commands, "set fit quiet nolog errorvariables", "glot_f(x) = "+expr)
+	for _, name := range names {
+		commands = append(commands, fmt.Sprintf("%s = %v", name, params[name]))
+	}
+	commands = append(commands, fmt.Sprintf("fit glot_f(x) %s using %s via %s", source, using, strings.Join(names, ",")),
+		`set print "-"`)
+	for _, name := range names {
+		commands = append(commands, fmt.Sprintf(`print sprintf("%s%s %%.17g %%.17g", %s, %s_err)`, fitMarker, name, name, name))
+	}
+	commands = append(commands, fmt.Sprintf(`print sprintf("%sFIT_STDFIT %%.17g %%d", FIT_STDFIT, FIT_NDF)`, fitMarker))
+
+	ctx := plot.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var out bytes.Buffer
+	err = plot.runScript(ctx, commands, &out)
+	if err != nil {
+		return nil, err
+	}
+	return parseFit(&out, names)
+}
+
+// dataSource returns the data file or datablock of a 1-d or 2-d PointGroup, for the scripts
+// that read it in a gnuplot process of their own, with the number of the columns of its points
+// and the command defining the datablock in that process, if needed.
+// The data files of both have 2 columns, the index of 1-d points is written as x.
+func (plot *Plot) dataSource(pointGroup *PointGroup) (string, int, []string, error) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	if pointGroup == nil || pointGroup.fname == "" {
+		return "", 0, nil, &gnuplotError{err: "The PointGroup has no data."}
+	}
+	columns := 0
+	switch data := pointGroup.castedData.(type) {
+	case []float64:
+		columns = 1
+	case [][]float64:
+		if len(data) == 2 {
+			columns = 2
+		}
+	}
+	if columns == 0 {
+		return "", 0, nil, &gnuplotError{err: fmt.Sprintf("Only the data of 1-d and 2-d points is supported, not the data of %s.", pointGroup.name), kind: ErrUnsupportedDataType}
+	}
+	source := quote(pointGroup.fname)
+	if pointGroup.binary {
+		source += " binary format='%float64%float64' endian=little"
+	}
+	var commands []string
+	if isBlock(pointGroup.fname) {
+		commands = append(commands, blockCmd(pointGroup.fname, plot.blocks[pointGroup.fname]))
+	}
+	return source, columns, commands, nil
+}
+
+// parseFit reads the results printed by the fit script.
+func parseFit(out *bytes.Buffer, names []string) (*FitResult, error) {
+	result := &FitResult{Params: make(map[string]float64), Errors: make(map[string]float64)}
+	stdfit := false
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		fields := strings.Fields(strings.TrimPrefix(scanner.Text(), fitMarker))
+		if !strings.HasPrefix(scanner.Text(), fitMarker) || len(fields) != 3 {
+			continue
+		}
+		value, err1 := strconv.ParseFloat(fields[1], 64)
+		if fields[0] == "FIT_STDFIT" {
+			ndf, err2 := strconv.Atoi(fields[2])
+			if err1 == nil && err2 == nil {
+				result.StdFit, result.NDF, stdfit = value, ndf, true
+			}
+			continue
+		}
+		stderr, err2 := strconv.ParseFloat(fields[2], 64)
+		if err1 == nil && err2 == nil {
+			result.Params[fields[0]] = value
+			result.Errors[fields[0]] = stderr
+		}
+	}
+	if !stdfit || len(result.Params) != len(names) {
+		return nil, &gnuplotError{err: "gnuplot printed no results of the fit."}
+	}
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package glot
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseFit(t *testing.T) {
+	out := bytes.NewBufferString("glot-fit a 2.5 0.1\nglot-fit b 0.25 0.01\nglot-fit FIT_STDFIT 0.5 8\n")
+	result, err := parseFit(out, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Params["a"] != 2.5 || result.Errors["b"] != 0.01 || result.StdFit != 0.5 || result.NDF != 8 {
+		t.Errorf("parseFit = %+v", result)
+	}
+	if _, err := parseFit(bytes.NewBufferString("glot-fit a 2.5 0.1\n"), []string{"a"}); err == nil {
+		t.Error("Expected an error without FIT_STDFIT.")
+	}
+
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	samples, _ := plot.Add("Samples", "points", [][]float64{{1, 2, 3}, {2, 4, 6}})
+	if _, err := plot.Fit("a*x", samples, map[string]float64{"a; quit": 1}); err == nil {
+		t.Error("Expected an error for a parameter name that is not a variable.")
+	}
+	plot.Fit("a*x", samples, map[string]float64{"a": 1})
+	expected := "fit glot_f(x) " + quote(samples.fname) + " using 1:2 via a"
+	history := plot.CommandHistory()
+	found := false
+	for _, cmd := range history {
+		found = found || cmd == expected
+	}
+	if !found {
+		t.Errorf("Expected %q in the commands %q", expected, history)
+	}
+}
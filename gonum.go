@@ -0,0 +1,103 @@
+package glot
+
+import (
+	"fmt"
+)
+
+// Matrix is a matrix of float64 values, satisfied by gonum's mat.Matrix types like
+// *mat.Dense, so that they can be plotted without copying them first and without
+// glot depending on gonum.
+type Matrix interface {
+	Dims() (r, c int)
+	At(i, j int) float64
+}
+
+// Vector is a vector of float64 values, satisfied by gonum's mat.Vector types like *mat.VecDense.
+type Vector interface {
+	Len() int
+	AtVec(i int) float64
+}
+
+// XYer is a sequence of points, satisfied by gonum/plot's plotter.XYer types like plotter.XYs.
+type XYer interface {
+	Len() int
+	XY(i int) (x, y float64)
+}
+
+// AddVector adds a curve of the values of v, like AddSeries1D.
+//
+// Usage
+//  v := mat.NewVecDense(4, []float64{51, 8, 4, 11})
+//  plot.AddVector("Sample1", "lines", v)
+func (plot *Plot) AddVector(name string, style string, v Vector) error {
+	return plot.AddSeries1D(name, style, vectorValues(v))
+}
+
+// AddVectorsXY adds a curve through the points (x[i], y[i]), like AddSeriesXY.
+func (plot *Plot) AddVectorsXY(name string, style string, x, y Vector) error {
+	return plot.AddSeriesXY(name, style, vectorValues(x), vectorValues(y))
+}
+
+// AddMatrixColumns adds a curve through the rows of m, with a column per axis:
+// x and y on a 2-d plot, x, y and z on a 3-d plot.
+//
+// Usage
+//  m := mat.NewDense(3, 2, []float64{1, 51, 2, 8, 3, 4})
+//  plot.AddMatrixColumns("Sample1", "points", m)
+func (plot *Plot) AddMatrixColumns(name string, style string, m Matrix) error {
+	rows, cols := m.Dims()
+	if cols != plot.dimensions {
+		return &gnuplotError{err: fmt.Sprintf("The matrix %s has %d columns but the plot has %d dimensions.", name, cols, plot.dimensions), kind: ErrInvalidDimensions}
+	}
+	columns := make([][]float64, cols)
+	for j := range columns {
+		columns[j] = make([]float64, rows)
+		for i := range columns[j] {
+			columns[j][i] = m.At(i, j)
+		}
+	}
+	if err := checkSeries(name, columns...); err != nil {
+		return err
+	}
+	return plot.AddPointGroup(name, style, columns)
+}
+
+// AddMatrixHeatmap adds m as a heatmap, with the first row at the bottom, colored with a
+// gnuplot palette definition, or the current palette when it is empty.
+//
+// Usage
+//  plot.AddMatrixHeatmap("Correlation", corr, "rgbformulae 33,13,10")
+func (plot *Plot) AddMatrixHeatmap(name string, m Matrix, palette string) error {
+	rows, cols := m.Dims()
+	matrix := make([][]float64, rows)
+	for i := range matrix {
+		matrix[i] = make([]float64, cols)
+		for j := range matrix[i] {
+			matrix[i][j] = m.At(i, j)
+		}
+	}
+	return plot.AddPointGroup(name, "heatmap", HeatmapData{Matrix: matrix, Palette: palette})
+}
+
+// AddXYs adds a curve through the points of data, like AddSeriesXY.
+//
+// Usage
+//  pts := plotter.XYs{{X: 1, Y: 51}, {X: 2, Y: 8}}
+//  plot.AddXYs("Sample1", "linespoints", pts)
+func (plot *Plot) AddXYs(name string, style string, data XYer) error {
+	x := make([]float64, data.Len())
+	y := make([]float64, data.Len())
+	for i := range x {
+		x[i], y[i] = data.XY(i)
+	}
+	return plot.AddSeriesXY(name, style, x, y)
+}
+
+// vectorValues copies the values of a vector.
+func vectorValues(v Vector) []float64 {
+	values := make([]float64, v.Len())
+	for i := range values {
+		values[i] = v.AtVec(i)
+	}
+	return values
+}
@@ -0,0 +1,41 @@
+package glot
+
+import (
+	"reflect"
+	"testing"
+)
+
+// dense is a row-major matrix like gonum's mat.Dense.
+type dense struct {
+	rows, cols int
+	data       []float64
+}
+
+func (m dense) Dims() (int, int)    { return m.rows, m.cols }
+func (m dense) At(i, j int) float64 { return m.data[i*m.cols+j] }
+
+// points is a sequence of points like gonum/plot's plotter.XYs.
+type points [][2]float64
+
+func (p points) Len() int                { return len(p) }
+func (p points) XY(i int) (x, y float64) { return p[i][0], p[i][1] }
+
+func TestAddMatrixColumns(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	err := plot.AddMatrixColumns("Sample1", "points", dense{3, 2, []float64{1, 51, 2, 8, 3, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]float64{{1, 2, 3}, {51, 8, 4}}
+	if !reflect.DeepEqual(plot.PointGroup["Sample1"].castedData, expected) {
+		t.Error("Unexpected data ", plot.PointGroup["Sample1"].castedData)
+	}
+	if plot.AddMatrixColumns("Sample2", "points", dense{2, 3, []float64{1, 2, 3, 4, 5, 6}}) == nil {
+		t.Error("Expected an error for a matrix with 3 columns on a 2-d plot.")
+	}
+	plot.AddXYs("Sample3", "lines", points{{1, 51}, {2, 8}, {3, 4}})
+	if !reflect.DeepEqual(plot.PointGroup["Sample3"].castedData, expected) {
+		t.Error("Unexpected data ", plot.PointGroup["Sample3"].castedData)
+	}
+}
@@ -0,0 +1,14 @@
+// Package gotaplot plots the columns of gota data frames with glot,
+// taking the names of the PointGroups from the column headers.
+//
+// The package depends on github.com/go-gota/gota, which glot itself doesn't need,
+// so it is only built with the gota build tag:
+//  go get github.com/go-gota/gota/dataframe
+//  go build -tags gota
+//
+// Usage
+//  df := dataframe.ReadCSV(f)
+//  plot, _ := glot.NewPlot(2, false, false)
+//  gotaplot.PlotColumns(plot, df, "epoch", "loss", "val_loss")
+//  plot.SavePlot("loss.png")
+package gotaplot
@@ -0,0 +1,89 @@
+//go:build gota
+// +build gota
+
+package gotaplot
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Arafatk/glot"
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// Style is the style of the curves added by PlotColumns.
+var Style = "lines"
+
+// PlotColumns adds a curve for each of the columns yCols against the column xCol,
+// named after the column. Int, float and bool columns are converted to numbers.
+// A string x column is treated as categories, drawn at 0, 1, 2, ... with the values as tic labels.
+// An empty xCol draws the y columns against the row numbers.
+func PlotColumns(plot *glot.Plot, df dataframe.DataFrame, xCol string, yCols ...string) error {
+	if df.Err != nil {
+		return df.Err
+	}
+	if len(yCols) == 0 {
+		return fmt.Errorf("gotaplot: no y columns to plot")
+	}
+	x := make([]float64, df.Nrow())
+	for i := range x {
+		x[i] = float64(i)
+	}
+	if xCol != "" {
+		column, err := col(df, xCol)
+		if err != nil {
+			return err
+		}
+		if column.Type() == series.String {
+			tics := make([]glot.Tic, column.Len())
+			for i, label := range column.Records() {
+				tics[i] = glot.Tic{Position: float64(i), Label: label}
+			}
+			err = plot.SetXTicLabels(tics...)
+		} else {
+			x, err = numbers(column)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range yCols {
+		column, err := col(df, name)
+		if err != nil {
+			return err
+		}
+		y, err := numbers(column)
+		if err != nil {
+			return err
+		}
+		err = plot.AddSeriesXY(name, Style, x, y)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// col returns the column of a data frame with the given name.
+func col(df dataframe.DataFrame, name string) (series.Series, error) {
+	column := df.Col(name)
+	if column.Err != nil {
+		return column, fmt.Errorf("gotaplot: %v", column.Err)
+	}
+	return column, nil
+}
+
+// numbers converts a column to float64 values, failing for strings that are not numbers.
+// Missing values become NaN, which gnuplot leaves out.
+func numbers(column series.Series) ([]float64, error) {
+	values := column.Float()
+	if column.Type() == series.String {
+		for i, value := range values {
+			if math.IsNaN(value) && !column.Elem(i).IsNA() {
+				return nil, fmt.Errorf("gotaplot: the value %q of column %s is not a number", column.Elem(i).String(), column.Name)
+			}
+		}
+	}
+	return values, nil
+}
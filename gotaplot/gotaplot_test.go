@@ -0,0 +1,32 @@
+//go:build gota
+// +build gota
+
+package gotaplot
+
+import (
+	"testing"
+
+	"github.com/Arafatk/glot"
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+func TestPlotColumns(t *testing.T) {
+	df := dataframe.New(
+		series.New([]int{1, 2, 3}, series.Int, "epoch"),
+		series.New([]float64{0.9, 0.5, 0.3}, series.Float, "loss"),
+		series.New([]string{"a", "b", "c"}, series.String, "name"),
+	)
+	plot, _ := glot.NewPlot(2, false, false)
+	defer plot.Close()
+	err := PlotColumns(plot, df, "epoch", "loss")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := plot.PointGroup["loss"]; !exists {
+		t.Error("Expected a PointGroup named after the column.")
+	}
+	if PlotColumns(plot, df, "epoch", "name") == nil {
+		t.Error("Expected an error for a column of strings.")
+	}
+}
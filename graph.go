@@ -0,0 +1,177 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Node is a node of a graph drawn by AddGraph.
+type Node struct {
+	Label string
+}
+
+// Edge is an edge of a graph drawn by AddGraph, between the nodes
+// with the indexes From and To.
+type Edge struct {
+	From int
+	To   int
+}
+
+// LayoutFunc returns the positions of the nodes of a graph, x[i], y[i] for nodes[i].
+// CircularLayout and ForceDirectedLayout are LayoutFuncs.
+type LayoutFunc func(nodes []Node, edges []Edge) (x, y []float64)
+
+// AddGraph draws a graph with the nodes as labeled points at the positions of the layout,
+// ForceDirectedLayout when nil, and the edges as lines between them. It is meant for
+// small graphs, like network topologies or the dependencies of a few packages.
+//
+// Usage
+//  nodes := []glot.Node{{Label: "api"}, {Label: "auth"}, {Label: "db"}}
+//  edges := []glot.Edge{{From: 0, To: 1}, {From: 0, To: 2}, {From: 1, To: 2}}
+//  plot.AddGraph(nodes, edges, glot.CircularLayout)
+func (plot *Plot) AddGraph(nodes []Node, edges []Edge, layout LayoutFunc) error {
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("Graphs must be 2-d plots."), kind: ErrInvalidDimensions}
+	}
+	if len(nodes) == 0 {
+		return &gnuplotError{err: fmt.Sprintf("The graph has no nodes."), kind: ErrEmptyData}
+	}
+	for i, edge := range edges {
+		if edge.From < 0 || edge.From >= len(nodes) || edge.To < 0 || edge.To >= len(nodes) {
+			return &gnuplotError{err: fmt.Sprintf("The edge %d from %d to %d joins nodes the graph of %d nodes doesn't have.", i, edge.From, edge.To, len(nodes)), kind: ErrInvalidIndex}
+		}
+	}
+	if layout == nil {
+		layout = ForceDirectedLayout
+	}
+	x, y := layout(nodes, edges)
+	if len(x) != len(nodes) || len(y) != len(nodes) {
+		return &gnuplotError{err: fmt.Sprintf("The layout placed %d and %d coordinates of %d nodes.", len(x), len(y), len(nodes)), kind: ErrLengthMismatch}
+	}
+
+	plot.mu.Lock()
+	const name = "graph nodes"
+	_, exists := plot.PointGroup[name]
+	plot.mu.Unlock()
+	if exists {
+		return &gnuplotError{err: fmt.Sprintf("The plot already has a graph."), kind: ErrDuplicatePointGroup}
+	}
+	// The edges are drawn first, under the nodes.
+	if len(edges) > 0 {
+		lines := VectorData{Head: "nohead"}
+		for _, edge := range edges {
+			lines.X = append(lines.X, x[edge.From])
+			lines.Y = append(lines.Y, y[edge.From])
+			lines.DX = append(lines.DX, x[edge.To]-x[edge.From])
+			lines.DY = append(lines.DY, y[edge.To]-y[edge.From])
+		}
+		err := plot.AddPointGroup("graph edges", "vectors", lines)
+		if err != nil {
+			return err
+		}
+	}
+
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	commands := []setting{{"size", "set size ratio -1"}, {"border", "unset border"},
+		{"xtics", "unset xtics"}, {"ytics", "unset ytics"}, {"key", "unset key"},
+		{"offsets", "set offsets 0.2, 0.2, 0.2, 0.2"}}
+	for _, command := range commands {
+		plot.keepSetting(command.key, command.cmd)
+		err := plot.Cmd("%s", command.cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	curve := &PointGroup{name: name, dimensions: 2, style: "labels", set: true, plot: plot}
+	f, err := plot.dataFile(curve)
+	if err != nil {
+		return err
+	}
+	for i, node := range nodes {
+		// Strings in data files can't escape their quotes.
+		label := strings.Replace(node.Label, `"`, `'`, -1)
+		f.WriteString(fmt.Sprintf("%v %v \"%s\"\n", x[i], y[i], label))
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	err = plot.sendPlotLine(curve, fmt.Sprintf("%s %s using 1:2:3 notitle with labels point pt 7 ps 1.5 offset char 0,1", cmd, quote(f.Name())))
+	plot.PointGroup[name] = curve
+	return err
+}
+
+// CircularLayout places the nodes evenly on a circle of radius 1, the first one on top,
+// going clockwise.
+func CircularLayout(nodes []Node, edges []Edge) ([]float64, []float64) {
+	x := make([]float64, len(nodes))
+	y := make([]float64, len(nodes))
+	for i := range nodes {
+		angle := math.Pi/2 - 2*math.Pi*float64(i)/float64(len(nodes))
+		x[i], y[i] = math.Cos(angle), math.Sin(angle)
+	}
+	return x, y
+}
+
+// ForceDirectedLayout places the nodes by the force-directed algorithm of Fruchterman and
+// Reingold, where the nodes repel each other and the edges pull their nodes together,
+// so that linked nodes end up close. It starts from CircularLayout, so it always
+// places the same graph the same way.
+func ForceDirectedLayout(nodes []Node, edges []Edge) ([]float64, []float64) {
+	const iterations = 200
+	x, y := CircularLayout(nodes, edges)
+	n := len(nodes)
+	if n < 2 {
+		return x, y
+	}
+	// k is the ideal distance of the nodes, so that they fill an area of 4.
+	k := math.Sqrt(4 / float64(n))
+	dx := make([]float64, n)
+	dy := make([]float64, n)
+	for iteration := 0; iteration < iterations; iteration++ {
+		for i := range dx {
+			dx[i], dy[i] = 0, 0
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				ex, ey := x[i]-x[j], y[i]-y[j]
+				d := math.Max(math.Hypot(ex, ey), 1e-6)
+				force := k * k / d
+				dx[i] += ex / d * force
+				dy[i] += ey / d * force
+				dx[j] -= ex / d * force
+				dy[j] -= ey / d * force
+			}
+		}
+		for _, edge := range edges {
+			if edge.From == edge.To {
+				continue
+			}
+			ex, ey := x[edge.From]-x[edge.To], y[edge.From]-y[edge.To]
+			d := math.Max(math.Hypot(ex, ey), 1e-6)
+			force := d * d / k
+			dx[edge.From] -= ex / d * force
+			dy[edge.From] -= ey / d * force
+			dx[edge.To] += ex / d * force
+			dy[edge.To] += ey / d * force
+		}
+		// The nodes move at most the temperature, which cools down to 0.
+		temperature := 0.2 * (1 - float64(iteration)/iterations)
+		for i := range x {
+			d := math.Hypot(dx[i], dy[i])
+			if d > 0 {
+				step := math.Min(d, temperature)
+				x[i] += dx[i] / d * step
+				y[i] += dy[i] / d * step
+			}
+		}
+	}
+	return x, y
+}
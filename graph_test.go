@@ -0,0 +1,44 @@
+package glot
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAddGraph(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	nodes := []Node{{"api"}, {"auth"}, {"db"}, {"cache"}}
+	edges := []Edge{{0, 1}, {0, 3}, {1, 2}, {3, 2}}
+	if err := plot.AddGraph(nodes, []Edge{{0, 4}}, CircularLayout); !errors.Is(err, ErrInvalidIndex) {
+		t.Error("Expected ErrInvalidIndex for an edge to a node that doesn't exist, got ", err)
+	}
+	if err := plot.AddGraph(nodes, []Edge{{-1, 2}}, CircularLayout); !errors.Is(err, ErrInvalidIndex) {
+		t.Error("Expected ErrInvalidIndex for an edge from a negative node, got ", err)
+	}
+	if err := plot.AddGraph(nil, nil, CircularLayout); !errors.Is(err, ErrEmptyData) {
+		t.Error("Expected ErrEmptyData for a graph without nodes, got ", err)
+	}
+	err := plot.AddGraph(nodes, edges, CircularLayout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := plot.PointGroup["graph edges"].castedData.(VectorData)
+	if len(lines.X) != 4 || math.Abs(lines.X[0]) > 1e-12 || lines.Y[0] != 1 || math.Abs(lines.DX[0]-1) > 1e-12 {
+		t.Error("Expected the edge from the top to the right of the circle, got ", lines.X[0], lines.Y[0], lines.DX[0])
+	}
+	if spec := strings.Join(plot.PointGroup["graph nodes"].specs, ", "); !strings.Contains(spec, "with labels point") {
+		t.Error("Expected the nodes to be drawn as labeled points, got ", spec)
+	}
+}
+
+func TestForceDirectedLayout(t *testing.T) {
+	// A path of 4 nodes: its ends end up further apart than its neighbors.
+	nodes := make([]Node, 4)
+	x, y := ForceDirectedLayout(nodes, []Edge{{0, 1}, {1, 2}, {2, 3}})
+	distance := func(i, j int) float64 { return math.Hypot(x[i]-x[j], y[i]-y[j]) }
+	if distance(0, 3) <= distance(0, 1) || distance(0, 3) <= distance(2, 3) {
+		t.Error("Expected the ends of the path apart, got ", x, y)
+	}
+}
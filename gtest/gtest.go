@@ -0,0 +1,158 @@
+// Package gtest compares plots to golden images in tests, so that changes to how
+// charts are drawn are caught in CI.
+//
+// The plots are drawn with a pinned terminal, size and font, and compared to the golden
+// image perceptually: the images are blurred slightly before comparing them, so that
+// the antialiasing differences of font and cairo versions don't count.
+// Set the environment variable GLOT_UPDATE_GOLDEN=1 to write the golden images instead.
+package gtest
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Arafatk/glot"
+)
+
+// T is the part of testing.TB used by CompareImage.
+type T interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// Terminal holds the terminal options the plots are drawn with, the same on every machine.
+var Terminal = glot.DeterministicTerminal
+
+// UpdateEnv is the environment variable that makes CompareImage write the golden images.
+const UpdateEnv = "GLOT_UPDATE_GOLDEN"
+
+// CompareImage draws the plot as a pngcairo image and fails the test when it differs from
+// the golden png file in more than tolerance, the fraction of the pixels that may differ,
+// like 0.01 for 1%. When it fails the image is written next to the golden file,
+// with .actual.png instead of .png, to look at the difference.
+//
+// Usage
+//  func TestChart(t *testing.T) {
+//  	plot, _ := glot.NewPlot(2, false, false)
+//  	defer plot.Close()
+//  	plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  	gtest.CompareImage(t, plot, "testdata/expected.png", 0.01)
+//  }
+func CompareImage(t T, plot *glot.Plot, golden string, tolerance float64) {
+	t.Helper()
+	var buf bytes.Buffer
+	err := plot.RenderWithOptions(&buf, "pngcairo", Terminal)
+	if err != nil {
+		t.Fatalf("could not draw the plot: %v", err)
+		return
+	}
+	if os.Getenv(UpdateEnv) != "" {
+		err = os.MkdirAll(filepath.Dir(golden), 0755)
+		if err == nil {
+			err = ioutil.WriteFile(golden, buf.Bytes(), 0644)
+		}
+		if err != nil {
+			t.Fatalf("could not write the golden image: %v", err)
+		}
+		return
+	}
+	actual, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("could not read the image drawn by gnuplot: %v", err)
+		return
+	}
+	expected, err := readImage(golden)
+	if err != nil {
+		t.Fatalf("could not read the golden image, run the test with %s=1 to write it: %v", UpdateEnv, err)
+		return
+	}
+	diff := Diff(expected, actual)
+	if diff <= tolerance {
+		return
+	}
+	output := strings.TrimSuffix(golden, ".png") + ".actual.png"
+	if err := ioutil.WriteFile(output, buf.Bytes(), 0644); err != nil {
+		output = err.Error()
+	}
+	t.Errorf("%.2f%% of the pixels differ from %s, more than %.2f%%, the plot is in %s",
+		diff*100, golden, tolerance*100, output)
+}
+
+func readImage(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+// threshold is the difference in the blurred brightness, from 0 to 1, from which pixels differ.
+const threshold = 0.1
+
+// Diff returns the fraction of the pixels of two images that differ perceptually,
+// from 0 for the same images to 1. Images of different sizes differ completely.
+func Diff(a, b image.Image) float64 {
+	sizeA, sizeB := a.Bounds().Size(), b.Bounds().Size()
+	if sizeA != sizeB {
+		return 1
+	}
+	if sizeA.X == 0 || sizeA.Y == 0 {
+		return 0
+	}
+	lumA, lumB := blur(luminance(a), sizeA), blur(luminance(b), sizeA)
+	differ := 0
+	for i := range lumA {
+		if math.Abs(lumA[i]-lumB[i]) > threshold {
+			differ++
+		}
+	}
+	return float64(differ) / float64(len(lumA))
+}
+
+// luminance returns the brightness of the pixels from 0 to 1, row by row,
+// on a white background for transparent pixels.
+func luminance(img image.Image) []float64 {
+	bounds := img.Bounds()
+	lum := make([]float64, 0, bounds.Dx()*bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			// The colors are premultiplied by alpha, add the white of the background.
+			white := float64(0xffff - a)
+			l := 0.299*(float64(r)+white) + 0.587*(float64(g)+white) + 0.114*(float64(b)+white)
+			lum = append(lum, l/0xffff)
+		}
+	}
+	return lum
+}
+
+// blur averages every pixel with its neighbors.
+func blur(lum []float64, size image.Point) []float64 {
+	blurred := make([]float64, len(lum))
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			var sum float64
+			n := 0
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					nx, ny := x+dx, y+dy
+					if nx < 0 || ny < 0 || nx >= size.X || ny >= size.Y {
+						continue
+					}
+					sum += lum[ny*size.X+nx]
+					n++
+				}
+			}
+			blurred[y*size.X+x] = sum / float64(n)
+		}
+	}
+	return blurred
+}
@@ -0,0 +1,35 @@
+package gtest
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func square(offset int) image.Image {
+	img := image.NewGray(image.Rect(0, 0, 40, 40))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+	for y := 10; y < 20; y++ {
+		for x := 10 + offset; x < 20+offset; x++ {
+			img.SetGray(x, y, color.Gray{0})
+		}
+	}
+	return img
+}
+
+func TestDiff(t *testing.T) {
+	if diff := Diff(square(0), square(0)); diff != 0 {
+		t.Errorf("Diff of the same images = %v", diff)
+	}
+	if diff := Diff(square(0), image.NewGray(image.Rect(0, 0, 20, 20))); diff != 1 {
+		t.Errorf("Diff of images of different sizes = %v", diff)
+	}
+	// A square moved by a pixel only differs at its left and right edges.
+	moved := Diff(square(0), square(1))
+	far := Diff(square(0), square(15))
+	if moved <= 0 || moved >= far || far > 0.2 {
+		t.Errorf("Diff of a moved square = %v, of a square moved far = %v", moved, far)
+	}
+}
@@ -0,0 +1,25 @@
+package glot
+
+// SetHeadless makes the plot draw nothing until it is saved, with gnuplot's unknown terminal,
+// so that it works without a display server, like in containers and on CI machines.
+// Without it gnuplot draws every change in a window of its default terminal, which fails
+// or hangs when there is no X11 or Wayland display. SavePlot and Render are not affected,
+// and the unknown terminal is selected again after saving.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, false, false)
+//  plot.SetHeadless()
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  plot.SavePlot("1.png")
+func (plot *Plot) SetHeadless() error {
+	plot.mu.Lock()
+	plot.screen = "unknown"
+	plot.winTitle, plot.persist = "", ""
+	plot.mu.Unlock()
+	return plot.Cmd("set terminal unknown")
+}
+
+// WithHeadless makes the plot headless, see SetHeadless. A headless plot doesn't persist.
+func WithHeadless() Option {
+	return func(o *plotOptions) { o.headless = true }
+}
@@ -0,0 +1,23 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeadless(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithHeadless(), WithPersist(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	if strings.Contains(strings.Join(plot.proc.handle.Args, " "), "-persist") {
+		t.Error("Expected a headless plot not to persist.")
+	}
+	plot.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	plot.SavePlot("headless.png")
+	script := plot.DumpScript()
+	if !strings.HasPrefix(script, "set terminal unknown\n") || !strings.HasSuffix(script, "set output\nset terminal unknown\n") {
+		t.Error("Expected the unknown terminal before drawing and after saving:\n", script)
+	}
+}
@@ -0,0 +1,125 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// HistogramOptions controls how values are binned by AddHistogramAdvance.
+type HistogramOptions struct {
+	Bins       int       // Number of equal width bins, ignored when Edges is set
+	Edges      []float64 // Custom bin edges in increasing order
+	Density    bool      // Normalize the bins so that the total area is 1
+	Cumulative bool      // Every bin also counts the values of all previous bins
+}
+
+// HistogramData is a frequency table that is plotted with boxes.
+// X holds the centers of the bins, Y their heights and Width their widths.
+type HistogramData struct {
+	X     []float64
+	Y     []float64
+	Width []float64
+}
+
+// NewHistogramData bins the values according to the options and returns the frequency table.
+// NaN values and values outside of the bin edges, like infinite values, are ignored.
+func NewHistogramData(values []float64, options HistogramOptions) (HistogramData, error) {
+	edges := options.Edges
+	if edges == nil {
+		if options.Bins <= 0 {
+			return HistogramData{}, &gnuplotError{err: fmt.Sprintf("invalid number of bins '%v'", options.Bins)}
+		}
+		if len(values) == 0 {
+			return HistogramData{}, &gnuplotError{err: fmt.Sprintf("can't compute the bins of an empty array")}
+		}
+		var e extent
+		e.add(values...)
+		if e.empty() {
+			return HistogramData{}, &gnuplotError{err: fmt.Sprintf("can't compute the bins of values that are all NaN or infinite")}
+		}
+		low, high := e.min, e.max
+		if low == high {
+			low, high = low-0.5, high+0.5
+		}
+		edges = make([]float64, options.Bins+1)
+		for i := range edges {
+			edges[i] = low + (high-low)*float64(i)/float64(options.Bins)
+		}
+		edges[options.Bins] = high
+	}
+	if len(edges) < 2 || !sort.Float64sAreSorted(edges) {
+		return HistogramData{}, &gnuplotError{err: fmt.Sprintf("bin edges must contain at least 2 values in increasing order")}
+	}
+
+	nbins := len(edges) - 1
+	counts := make([]float64, nbins)
+	total := 0.0
+	for _, v := range values {
+		if math.IsNaN(v) || v < edges[0] || v > edges[nbins] {
+			continue
+		}
+		i := sort.SearchFloat64s(edges, v)
+		if i == nbins || edges[i] != v {
+			i--
+		}
+		counts[i]++
+		total++
+	}
+
+	hist := HistogramData{
+		X:     make([]float64, nbins),
+		Y:     make([]float64, nbins),
+		Width: make([]float64, nbins),
+	}
+	sum := 0.0
+	for i := 0; i < nbins; i++ {
+		hist.Width[i] = edges[i+1] - edges[i]
+		hist.X[i] = edges[i] + hist.Width[i]/2
+		sum += counts[i]
+		y := counts[i]
+		if options.Cumulative {
+			y = sum
+		}
+		if options.Density && total > 0 {
+			// A cumulative density is the fraction of values seen so far.
+			if options.Cumulative {
+				y /= total
+			} else {
+				y /= total * hist.Width[i]
+			}
+		}
+		hist.Y[i] = y
+	}
+	return hist, nil
+}
+
+// AddHistogram bins the values into the given number of equal width bins
+// and plots the frequency of every bin with boxes.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddHistogram("Samples", []float64{1, 2, 2, 3, 3, 3, 4}, 4)
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddHistogram(name string, values []float64, bins int) error {
+	return plot.AddHistogramAdvance(name, values, HistogramOptions{Bins: bins})
+}
+
+// AddHistogramAdvance is AddHistogram with control over the bin edges,
+// normalization and accumulation of the histogram.
+//
+// Usage
+//  plot.AddHistogramAdvance("Samples", values, glot.HistogramOptions{
+//  	Edges:   []float64{0, 1, 5, 10},
+//  	Density: true,
+//  })
+func (plot *Plot) AddHistogramAdvance(name string, values []float64, options HistogramOptions) error {
+	hist, err := NewHistogramData(values, options)
+	if err != nil {
+		return err
+	}
+	return plot.AddPointGroup(name, "boxes", hist)
+}
@@ -0,0 +1,31 @@
+package glot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewHistogramData(t *testing.T) {
+	hist, _ := NewHistogramData([]float64{0, 1, 1, 2, 3, 4}, HistogramOptions{Bins: 2})
+	if hist.Y[0] != 3 || hist.Y[1] != 3 {
+		t.Error("Expected 3 values in each bin, got ", hist.Y)
+	}
+}
+
+func TestNewHistogramDataNaN(t *testing.T) {
+	values := []float64{math.NaN(), 0, 1, math.Inf(1), 2, 3, math.Inf(-1)}
+	hist, err := NewHistogramData(values, HistogramOptions{Bins: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hist.X[0] != 0.5 || hist.Y[0] != 1 || hist.Y[2] != 2 {
+		t.Error("Expected the bins of 0 to 3 without NaN and infinite values, got ", hist.X, hist.Y)
+	}
+	hist, err = NewHistogramData(values, HistogramOptions{Edges: []float64{0, 2, 4}})
+	if err != nil || hist.Y[0] != 2 || hist.Y[1] != 2 {
+		t.Error("Expected NaN to be ignored with custom edges, got ", hist.Y, err)
+	}
+	if _, err := NewHistogramData([]float64{math.NaN()}, HistogramOptions{Bins: 3}); err == nil {
+		t.Error("Expected an error for values that are all NaN.")
+	}
+}
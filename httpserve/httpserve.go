@@ -0,0 +1,147 @@
+// Package httpserve serves glot plots over HTTP, drawn on demand,
+// so that services can expose live charts without writing image files.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, false, false)
+//  plot.AddPointGroup("Latency", "lines", latencies)
+//  http.Handle("/metrics/chart", httpserve.New(plot))
+//  http.ListenAndServe(":8080", nil)
+package httpserve
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Arafatk/glot"
+)
+
+// formats maps the formats a plot is served in to their content types.
+var formats = map[string]string{
+	"svg":  "image/svg+xml",
+	"png":  "image/png",
+	"webp": "image/webp",
+	"pdf":  "application/pdf",
+}
+
+// Handler is an http.Handler drawing a plot in the format asked for by the request,
+// either with the format query parameter, like ?format=svg, or with the Accept header.
+// PNG is served when neither selects a format. Images are cached until the plot changes
+// and carry an ETag, so that clients can revalidate them cheaply.
+type Handler struct {
+	plot   *glot.Plot
+	maxAge time.Duration
+	mu     sync.Mutex
+	cache  map[string]image // by format
+}
+
+// image is a drawn plot.
+type image struct {
+	etag     string
+	data     []byte
+	rendered time.Time
+}
+
+// New makes a handler serving the plot.
+func New(plot *glot.Plot) *Handler {
+	return &Handler{plot: plot, cache: make(map[string]image)}
+}
+
+// SetMaxAge lets the handler serve a cached image for up to maxAge without checking
+// whether the plot changed, and tells clients to do the same with Cache-Control.
+// This bounds the work of plots that change all the time, like live plots.
+func (h *Handler) SetMaxAge(maxAge time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.maxAge = maxAge
+}
+
+// ServeHTTP draws the plot, or answers 304 Not Modified when the client has the current image.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format, ok := negotiate(r)
+	if !ok {
+		http.Error(w, "unsupported format, use one of svg, png, webp and pdf", http.StatusNotAcceptable)
+		return
+	}
+	img, maxAge, err := h.image(format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("ETag", img.etag)
+	w.Header().Set("Vary", "Accept")
+	if maxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(maxAge/time.Second)))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	if match := r.Header.Get("If-None-Match"); match != "" && match == img.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", formats[format])
+	w.Write(img.data)
+}
+
+// image returns the plot drawn in format, from the cache when the plot didn't change,
+// and the max age of the image.
+func (h *Handler) image(format string) (image, time.Duration, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	cached, exists := h.cache[format]
+	if exists && h.maxAge > 0 && time.Since(cached.rendered) < h.maxAge {
+		return cached, h.maxAge, nil
+	}
+	// The script of the plot grows with every change, including new data.
+	sum := sha1.Sum([]byte(format + "\n" + h.plot.DumpScript()))
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	if exists && cached.etag == etag {
+		cached.rendered = time.Now()
+		h.cache[format] = cached
+		return cached, h.maxAge, nil
+	}
+	data, err := h.plot.RenderBytes(format)
+	if err != nil {
+		return image{}, 0, err
+	}
+	img := image{etag: etag, data: data, rendered: time.Now()}
+	h.cache[format] = img
+	return img, h.maxAge, nil
+}
+
+// negotiate returns the format asked for by the request.
+func negotiate(r *http.Request) (string, bool) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		_, ok := formats[format]
+		return format, ok
+	}
+	accept := r.Header.Get("Accept")
+	if accept == "" {
+		return "png", true
+	}
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q == 0 {
+			continue
+		}
+		switch mediaType {
+		case "image/*", "*/*":
+			return "png", true
+		}
+		for format, contentType := range formats {
+			if mediaType == contentType {
+				return format, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,49 @@
+package httpserve
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Arafatk/glot"
+)
+
+func TestServeHTTP(t *testing.T) {
+	plot, _ := glot.NewPlot(2, false, false)
+	defer plot.Close()
+	plot.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	handler := New(plot)
+
+	r := httptest.NewRequest("GET", "/chart", nil)
+	r.Header.Set("Accept", "text/html, image/svg+xml;q=0.9")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "image/svg+xml" {
+		t.Fatal("Unexpected response ", w.Code, w.Header())
+	}
+	etag := w.Header().Get("ETag")
+
+	r = httptest.NewRequest("GET", "/chart?format=svg", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusNotModified {
+		t.Error("Expected 304 for an unchanged plot, got ", w.Code)
+	}
+
+	plot.SetTitle("Changed")
+	r = httptest.NewRequest("GET", "/chart?format=svg", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusOK || w.Header().Get("ETag") == etag {
+		t.Error("Expected a new image for a changed plot, got ", w.Code)
+	}
+
+	r = httptest.NewRequest("GET", "/chart?format=gif", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusNotAcceptable {
+		t.Error("Expected 406 for an unsupported format, got ", w.Code)
+	}
+}
@@ -0,0 +1,149 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+)
+
+// SMA returns the simple moving average of the values over period values.
+// The first period-1 averages are NaN, which gnuplot leaves out of the plot.
+// It returns nil for a period below 1.
+func SMA(values []float64, period int) []float64 {
+	if period < 1 {
+		return nil
+	}
+	sma := make([]float64, len(values))
+	sum := 0.0
+	for i, v := range values {
+		sum += v
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i < period-1 {
+			sma[i] = math.NaN()
+		} else {
+			sma[i] = sum / float64(period)
+		}
+	}
+	return sma
+}
+
+// EMA returns the exponential moving average of the values with the smoothing factor 2/(period+1).
+// It starts with the simple average of the first period values, the averages before it are NaN.
+// It returns nil for a period below 1.
+func EMA(values []float64, period int) []float64 {
+	if period < 1 {
+		return nil
+	}
+	ema := make([]float64, len(values))
+	alpha := 2 / float64(period+1)
+	sum := 0.0
+	for i, v := range values {
+		switch {
+		case i < period-1:
+			sum += v
+			ema[i] = math.NaN()
+		case i == period-1:
+			ema[i] = (sum + v) / float64(period)
+		default:
+			ema[i] = alpha*v + (1-alpha)*ema[i-1]
+		}
+	}
+	return ema
+}
+
+// BollingerBands returns the simple moving average of the values over period values
+// and the bands k standard deviations above and below it.
+// It returns nil for a period below 1.
+func BollingerBands(values []float64, period int, k float64) (middle, upper, lower []float64) {
+	if period < 1 {
+		return nil, nil, nil
+	}
+	middle = SMA(values, period)
+	upper = make([]float64, len(values))
+	lower = make([]float64, len(values))
+	for i := range values {
+		if i < period-1 {
+			upper[i], lower[i] = math.NaN(), math.NaN()
+			continue
+		}
+		variance := 0.0
+		for _, v := range values[i-period+1 : i+1] {
+			variance += (v - middle[i]) * (v - middle[i])
+		}
+		deviation := math.Sqrt(variance / float64(period))
+		upper[i], lower[i] = middle[i]+k*deviation, middle[i]-k*deviation
+	}
+	return middle, upper, lower
+}
+
+// ClosePrices returns the x values and the close prices of the candles,
+// to compute indicators for a candlestick chart.
+func (data CandlesticksData) ClosePrices() (x, closes []float64) {
+	n := len(data.Candles)
+	x = make([]float64, n)
+	closes = make([]float64, n)
+	for i, candle := range data.Candles {
+		if len(data.Timestamps) > 0 {
+			x[i] = float64(data.Timestamps[i])
+		} else {
+			x[i] = float64(data.XArray[i])
+		}
+		closes[i] = candle[3]
+	}
+	return x, closes
+}
+
+func checkIndicator(x, prices []float64, period int) error {
+	if len(x) != len(prices) {
+		return &gnuplotError{err: fmt.Sprintf("The length of the x-axis array and the prices array are not same.")}
+	}
+	if period <= 0 {
+		return &gnuplotError{err: fmt.Sprintf("invalid period '%v'", period)}
+	}
+	return nil
+}
+
+// AddSMA adds a line with the simple moving average of the prices over period values.
+//
+// Usage
+//  plot.AddPointGroup("Prices", "candlesticks", candles)
+//  x, closes := candles.ClosePrices()
+//  plot.AddSMA("SMA 20", x, closes, 20)
+func (plot *Plot) AddSMA(name string, x, prices []float64, period int) error {
+	if err := checkIndicator(x, prices, period); err != nil {
+		return err
+	}
+	return plot.AddPointGroup(name, "lines", [][]float64{x, SMA(prices, period)})
+}
+
+// AddEMA adds a line with the exponential moving average of the prices over period values.
+func (plot *Plot) AddEMA(name string, x, prices []float64, period int) error {
+	if err := checkIndicator(x, prices, period); err != nil {
+		return err
+	}
+	return plot.AddPointGroup(name, "lines", [][]float64{x, EMA(prices, period)})
+}
+
+// AddBollingerBands adds the moving average of the prices over period values and
+// the bands k standard deviations around it, as the PointGroups name, name+" upper"
+// and name+" lower".
+//
+// Usage
+//  x, closes := candles.ClosePrices()
+//  plot.AddBollingerBands("Bollinger", x, closes, 20, 2)
+func (plot *Plot) AddBollingerBands(name string, x, prices []float64, period int, k float64) error {
+	if err := checkIndicator(x, prices, period); err != nil {
+		return err
+	}
+	middle, upper, lower := BollingerBands(prices, period, k)
+	err := plot.AddPointGroup(name, "lines", [][]float64{x, middle})
+	if err != nil {
+		return err
+	}
+	err = plot.AddPointGroup(name+" upper", "lines", [][]float64{x, upper})
+	if err != nil {
+		return err
+	}
+	return plot.AddPointGroup(name+" lower", "lines", [][]float64{x, lower})
+}
@@ -0,0 +1,34 @@
+package glot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIndicators(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5}
+	sma := SMA(values, 3)
+	if !math.IsNaN(sma[1]) || sma[2] != 2 || sma[4] != 4 {
+		t.Error("Unexpected SMA ", sma)
+	}
+	ema := EMA(values, 3)
+	if ema[2] != 2 || ema[3] != 3 {
+		t.Error("Unexpected EMA ", ema)
+	}
+	_, upper, lower := BollingerBands([]float64{2, 2, 2}, 3, 2)
+	if upper[2] != 2 || lower[2] != 2 {
+		t.Error("Expected no band around constant values, got ", upper, lower)
+	}
+	for _, period := range []int{0, -1} {
+		if SMA(values, period) != nil || EMA(values, period) != nil {
+			t.Error("Expected no averages for the period ", period)
+		}
+		if middle, _, _ := BollingerBands(values, period, 2); middle != nil {
+			t.Error("Expected no bands for the period ", period)
+		}
+	}
+	x, closes := CandlesticksData{XArray: []int64{7}, Candles: [][]float64{{1, 4, 0.5, 3}}}.ClosePrices()
+	if x[0] != 7 || closes[0] != 3 {
+		t.Error("Unexpected close prices ", x, closes)
+	}
+}
@@ -0,0 +1,97 @@
+package glot
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+)
+
+// InlineProtocol is a protocol of terminal emulators showing images in the terminal, see ShowInline.
+type InlineProtocol int
+
+// The supported protocols.
+const (
+	InlineAuto   InlineProtocol = iota // Detected from the environment, sixel when unknown
+	InlineSixel                        // Sixel graphics, supported by xterm, mlterm, foot, WezTerm and others
+	InlineKitty                        // The graphics protocol of kitty
+	InlineITerm2                       // The inline images of iTerm2, also supported by WezTerm
+)
+
+// ShowInline draws the plot as an image in the terminal the program runs in, with a protocol
+// of modern terminal emulators, instead of opening a window. The image is written to w,
+// usually os.Stdout, in the size set by SetOutputSize. Sixel graphics need the sixelgd
+// terminal of gnuplot, the other protocols the png terminal.
+//
+// Usage
+//  plot.SetOutputSize(800, 500)
+//  plot.ShowInline(os.Stdout, glot.InlineAuto)
+func (plot *Plot) ShowInline(w io.Writer, protocol InlineProtocol) error {
+	if protocol == InlineAuto {
+		protocol = detectInlineProtocol()
+	}
+	if protocol == InlineSixel {
+		if plot.empty() {
+			return &gnuplotError{err: fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+		}
+		plot.mu.Lock()
+		options := plot.terminal
+		plot.mu.Unlock()
+		return plot.renderWith(w, terminalCommand("sixelgd", options))
+	}
+	image, err := plot.RenderBytes("png")
+	if err != nil {
+		return err
+	}
+	switch protocol {
+	case InlineKitty:
+		_, err = io.WriteString(w, kittyImage(image))
+	case InlineITerm2:
+		_, err = io.WriteString(w, iterm2Image(image))
+	default:
+		return &gnuplotError{err: fmt.Sprintf("The inline protocol %d is unknown.", protocol)}
+	}
+	return err
+}
+
+// detectInlineProtocol guesses the protocol of the terminal from the environment.
+func detectInlineProtocol() InlineProtocol {
+	switch {
+	case os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("TERM") == "xterm-kitty":
+		return InlineKitty
+	case os.Getenv("TERM_PROGRAM") == "iTerm.app" || os.Getenv("TERM_PROGRAM") == "WezTerm":
+		return InlineITerm2
+	}
+	return InlineSixel
+}
+
+// kittyImage returns the escape sequences showing a PNG image with kitty's graphics protocol,
+// which takes the base64 encoded image in chunks of at most 4096 bytes.
+func kittyImage(png []byte) string {
+	const chunkSize = 4096
+	data := base64.StdEncoding.EncodeToString(png)
+	var out bytes.Buffer
+	for first := true; first || len(data) > 0; first = false {
+		chunk := data
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		data = data[len(chunk):]
+		more := 0
+		if len(data) > 0 {
+			more = 1
+		}
+		if first {
+			fmt.Fprintf(&out, "\x1b_Gf=100,a=T,m=%d;%s\x1b\\", more, chunk)
+		} else {
+			fmt.Fprintf(&out, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+	return out.String() + "\n"
+}
+
+// iterm2Image returns the escape sequence showing a PNG image as an inline image of iTerm2.
+func iterm2Image(png []byte) string {
+	return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;preserveAspectRatio=1:%s\a\n", len(png), base64.StdEncoding.EncodeToString(png))
+}
@@ -0,0 +1,17 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKittyImage(t *testing.T) {
+	image := kittyImage(make([]byte, 6000))
+	chunks := strings.Split(strings.TrimSuffix(image, "\x1b\\\n"), "\x1b\\")
+	if len(chunks) != 2 || !strings.HasPrefix(chunks[0], "\x1b_Gf=100,a=T,m=1;") || !strings.HasPrefix(chunks[1], "\x1b_Gm=0;") {
+		t.Errorf("Unexpected chunks %q", chunks)
+	}
+	if !strings.HasPrefix(iterm2Image([]byte("png")), "\x1b]1337;File=inline=1;size=3;preserveAspectRatio=1:cG5n\a") {
+		t.Errorf("Unexpected iTerm2 image %q", iterm2Image([]byte("png")))
+	}
+}
@@ -0,0 +1,106 @@
+package glot
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Rect is a rectangle in graph coordinates, from 0 at the left or bottom
+// to 1 at the right or top edge of the graph, see AddInset.
+type Rect struct {
+	X, Y          float64 // Bottom left corner
+	Width, Height float64
+}
+
+// inset is a plot drawn over an area of the graph of another plot.
+type inset struct {
+	plot   *Plot
+	region Rect
+}
+
+// AddInset adds a small plot drawn over the region of the graph of the plot,
+// for instance to show a zoomed part of the data. The inset is a plot of its own,
+// with its own PointGroups and settings, that is only drawn when the plot is saved
+// or rendered, together with the plot in gnuplot's multiplot mode.
+// The inset is closed with the plot.
+//
+// Usage
+//  plot.AddPointGroup("Signal", "lines", signal)
+//  zoom, _ := plot.AddInset(glot.Rect{X: 0.6, Y: 0.6, Width: 0.35, Height: 0.35})
+//  zoom.AddPointGroup("Signal", "lines", signal)
+//  zoom.SetXrange(100, 120)
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddInset(region Rect) (*Plot, error) {
+	if region.X < 0 || region.Y < 0 || region.Width <= 0 || region.Height <= 0 ||
+		region.X+region.Width > 1 || region.Y+region.Height > 1 {
+		return nil, &gnuplotError{err: fmt.Sprintf("The region %+v is not inside the graph.", region)}
+	}
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	p, err := newRecordingPlot(plot.ctx, plot.dimensions, plot.debug)
+	if err != nil {
+		return nil, err
+	}
+	p.tempDir = plot.tempDir
+	plot.insets = append(plot.insets, inset{plot: p, region: region})
+	return p, nil
+}
+
+// insetScript returns the commands drawing the plot with its insets in multiplot mode,
+// nil when the plot has no insets.
+func (plot *Plot) insetScript() []string {
+	plot.mu.Lock()
+	insets := append([]inset{}, plot.insets...)
+	plot.mu.Unlock()
+	if len(insets) == 0 {
+		return nil
+	}
+	commands := []string{"set multiplot"}
+	commands = append(commands, plot.settings()...)
+	commands = append(commands, plot.plotAll(),
+		// The graph of the plot in screen coordinates, GPVAL_TERM_XMIN and friends
+		// are in terminal units divided by the oversampling of the terminal.
+		"glot_x0 = real(GPVAL_TERM_XMIN)*GPVAL_TERM_SCALE/GPVAL_TERM_XSIZE",
+		"glot_x1 = real(GPVAL_TERM_XMAX)*GPVAL_TERM_SCALE/GPVAL_TERM_XSIZE",
+		"glot_y0 = real(GPVAL_TERM_YMIN)*GPVAL_TERM_SCALE/GPVAL_TERM_YSIZE",
+		"glot_y1 = real(GPVAL_TERM_YMAX)*GPVAL_TERM_SCALE/GPVAL_TERM_YSIZE")
+	for _, inset := range insets {
+		if inset.plot.empty() {
+			continue
+		}
+		r := inset.region
+		left := fmt.Sprintf("glot_x0 + %v*(glot_x1 - glot_x0)", r.X)
+		right := fmt.Sprintf("glot_x0 + %v*(glot_x1 - glot_x0)", r.X+r.Width)
+		bottom := fmt.Sprintf("glot_y0 + %v*(glot_y1 - glot_y0)", r.Y)
+		top := fmt.Sprintf("glot_y0 + %v*(glot_y1 - glot_y0)", r.Y+r.Height)
+		commands = append(commands, "reset")
+		commands = append(commands, inset.plot.settings()...)
+		commands = append(commands,
+			// Erase the curves of the plot under the inset.
+			fmt.Sprintf("set origin %s, %s", left, bottom),
+			fmt.Sprintf("set size %v*(glot_x1 - glot_x0), %v*(glot_y1 - glot_y0)", r.Width, r.Height),
+			"clear",
+			"set lmargin at screen "+left,
+			"set rmargin at screen "+right,
+			"set bmargin at screen "+bottom,
+			"set tmargin at screen "+top,
+			inset.plot.plotAll())
+	}
+	return append(commands, "unset multiplot")
+}
+
+// drawInsets draws the plot with its insets with a gnuplot process of its own,
+// since replot can't draw a multiplot again. It reports false when the plot has no insets.
+func (plot *Plot) drawInsets(ctx context.Context, terminal, output string, w io.Writer) (bool, error) {
+	script := plot.insetScript()
+	if script == nil {
+		return false, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	commands := append([]string{terminal, output}, script...)
+	commands = append(commands, "unset output")
+	return true, plot.runScript(ctx, commands, w)
+}
@@ -0,0 +1,32 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddInset(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	_, err := plot.AddInset(Rect{X: 0.8, Y: 0.1, Width: 0.3, Height: 0.3})
+	if err == nil {
+		t.Error("Expected an error for a region outside of the graph.")
+	}
+	if plot.insetScript() != nil {
+		t.Error("Expected no multiplot script without insets.")
+	}
+	plot.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	zoom, err := plot.AddInset(Rect{X: 0.6, Y: 0.5, Width: 0.3, Height: 0.4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zoom.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	zoom.SetXrange(1, 2)
+	script := strings.Join(plot.insetScript(), "\n")
+	for _, expected := range []string{"set multiplot\n", "set xrange [1:2]\n", "set lmargin at screen glot_x0 + 0.6*(glot_x1 - glot_x0)\n",
+		"set tmargin at screen glot_y0 + 0.9*(glot_y1 - glot_y0)\n", "\nunset multiplot"} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("Expected %q in the script:\n%s", expected, script)
+		}
+	}
+}
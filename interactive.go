@@ -0,0 +1,127 @@
+package glot
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// interactiveTerminals are the interactive terminals tried by SetInteractiveTerminal
+// in auto-detect mode, in order of preference for each operating system.
+var interactiveTerminals = map[string][]string{
+	"darwin":  {"qt", "aqua", "wxt", "x11"},
+	"windows": {"windows", "wxt", "qt"},
+	"default": {"qt", "wxt", "x11"},
+}
+
+// The terminals supported by the gnuplot binary, listed once per binary.
+var (
+	terminalsMu   sync.Mutex
+	terminalsList []string
+	terminalsFor  string
+)
+
+// AvailableTerminals returns the names of the terminals supported by the local gnuplot build.
+func AvailableTerminals() ([]string, error) {
+	terminalsMu.Lock()
+	defer terminalsMu.Unlock()
+	if gGnuplotCmd == "" {
+		return nil, &gnuplotError{err: "could not find path to 'gnuplot'", kind: ErrGnuplotNotFound}
+	}
+	if terminalsFor == gGnuplotCmd {
+		return terminalsList, nil
+	}
+	out, err := exec.Command(gGnuplotCmd, "-e", "set terminal").CombinedOutput()
+	if err != nil {
+		return nil, err
+	}
+	terminalsList, terminalsFor = parseTerminals(out), gGnuplotCmd
+	return terminalsList, nil
+}
+
+// parseTerminals reads the terminal names from the output of "set terminal".
+func parseTerminals(out []byte) []string {
+	var names []string
+	listing := false
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "Available terminal types") {
+			listing = true
+			continue
+		}
+		fields := strings.Fields(line)
+		if listing && len(fields) > 0 {
+			names = append(names, fields[0])
+		}
+	}
+	return names
+}
+
+// SetInteractiveTerminal selects the terminal the plot is shown with, like "qt", "wxt",
+// "x11", "aqua" or "windows". With "auto" or an empty name the first interactive terminal
+// supported by the local gnuplot build is used, and gnuplot's default is kept when there is none.
+// The terminal is restored after the plot is saved to a file.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, true, false)
+//  plot.SetInteractiveTerminal("auto")
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+func (plot *Plot) SetInteractiveTerminal(name string) error {
+	supported, err := AvailableTerminals()
+	if err != nil {
+		return err
+	}
+	if name == "" || name == "auto" {
+		name = detectInteractiveTerminal(supported)
+		if name == "" {
+			return nil
+		}
+	} else if !contains(supported, name) {
+		return &gnuplotError{err: fmt.Sprintf("gnuplot does not support the terminal %q", name), kind: ErrUnknownTerminal}
+	}
+	plot.mu.Lock()
+	plot.screen = name
+	cmd := plot.screenCmd()
+	plot.mu.Unlock()
+	return plot.Cmd("%s", cmd)
+}
+
+// detectInteractiveTerminal returns the preferred interactive terminal among the supported ones.
+func detectInteractiveTerminal(supported []string) string {
+	candidates, exists := interactiveTerminals[runtime.GOOS]
+	if !exists {
+		candidates = interactiveTerminals["default"]
+	}
+	for _, name := range candidates {
+		if contains(supported, name) {
+			return name
+		}
+	}
+	return ""
+}
+
+// restoreTerminal switches back to the interactive terminal after saving to a file.
+func (plot *Plot) restoreTerminal() error {
+	plot.mu.Lock()
+	screen := plot.screen
+	cmd := plot.screenCmd()
+	plot.mu.Unlock()
+	if screen == "" {
+		return nil
+	}
+	return plot.Cmd("set output\n%s", cmd)
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package glot
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTerminals(t *testing.T) {
+	out := []byte(`
+Available terminal types:
+           cairolatex  LaTeX picture environment using graphicx package and Cairo backend
+                  png  PNG images using libgd and TrueType fonts
+                   qt  Qt cross-platform interactive terminal
+`)
+	names := parseTerminals(out)
+	if !reflect.DeepEqual(names, []string{"cairolatex", "png", "qt"}) {
+		t.Error("Unexpected terminals ", names)
+	}
+	if detectInteractiveTerminal(names) != "qt" {
+		t.Error("Expected qt to be detected")
+	}
+}
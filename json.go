@@ -0,0 +1,114 @@
+package glot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// AddFromJSON adds a curve read from a JSON document, with the x and y values selected
+// by dotted paths like "data.points.t". A path walks into the fields of objects, and into
+// all elements of arrays, or into one element when the part of the path is an index, so
+// "series.0.values.v" takes the field v of all values of the first series.
+// Numbers, numeric strings and booleans are accepted, null is a missing value.
+// The curve is named after yPath and drawn in the style of the plot. When xPath is
+// empty the y values are drawn against their index.
+//
+// Usage
+//  // {"points": [{"t": 1, "latency": {"p99": 12.5}}, {"t": 2, "latency": {"p99": 9.1}}]}
+//  resp, _ := http.Get(url)
+//  defer resp.Body.Close()
+//  plot.AddFromJSON(resp.Body, "points.t", "points.latency.p99")
+func (plot *Plot) AddFromJSON(r io.Reader, xPath, yPath string) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var document interface{}
+	err := decoder.Decode(&document)
+	if err != nil {
+		return err
+	}
+	y, err := jsonNumbers(document, yPath)
+	if err != nil {
+		return err
+	}
+	if xPath == "" {
+		return plot.AddSeries1D(yPath, "", y)
+	}
+	x, err := jsonNumbers(document, xPath)
+	if err != nil {
+		return err
+	}
+	return plot.AddSeriesXY(yPath, "", x, y)
+}
+
+// jsonNumbers returns the values at a dotted path of a JSON document as numbers.
+func jsonNumbers(document interface{}, path string) ([]float64, error) {
+	var keys []string
+	if path != "" {
+		keys = strings.Split(path, ".")
+	}
+	values, err := jsonSelect(document, keys, nil)
+	if err != nil {
+		return nil, &gnuplotError{err: fmt.Sprintf("The path %s can't be followed: %v", path, err)}
+	}
+	numbers := make([]float64, len(values))
+	for i, value := range values {
+		switch value := value.(type) {
+		case nil:
+			numbers[i] = math.NaN()
+		case json.Number:
+			numbers[i], err = value.Float64()
+		case string:
+			numbers[i], err = strconv.ParseFloat(value, 64)
+		case bool:
+			if value {
+				numbers[i] = 1
+			}
+		default:
+			err = fmt.Errorf("%T", value)
+		}
+		if err != nil {
+			return nil, &gnuplotError{err: fmt.Sprintf("The value %v at %s is not a number.", value, path), kind: ErrUnsupportedDataType}
+		}
+	}
+	return numbers, nil
+}
+
+// jsonSelect appends the values found by following keys from value to values.
+func jsonSelect(value interface{}, keys []string, values []interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		if len(keys) > 0 {
+			if index, err := strconv.Atoi(keys[0]); err == nil {
+				if index < 0 || index >= len(v) {
+					return nil, fmt.Errorf("index %d is out of range", index)
+				}
+				return jsonSelect(v[index], keys[1:], values)
+			}
+		}
+		var err error
+		for _, element := range v {
+			values, err = jsonSelect(element, keys, values)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	case map[string]interface{}:
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("it ends at an object")
+		}
+		field, exists := v[keys[0]]
+		if !exists {
+			return nil, fmt.Errorf("no field %s", keys[0])
+		}
+		return jsonSelect(field, keys[1:], values)
+	}
+	if len(keys) > 0 {
+		return nil, fmt.Errorf("%v has no field %s", value, keys[0])
+	}
+	return append(values, value), nil
+}
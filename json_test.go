@@ -0,0 +1,24 @@
+package glot
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAddFromJSON(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	payload := `{"series": [{"points": [{"t": 1, "v": {"p99": 12.5}}, {"t": "2", "v": {"p99": 9}}]}]}`
+	err := plot.AddFromJSON(strings.NewReader(payload), "series.0.points.t", "series.points.v.p99")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]float64{{1, 2}, {12.5, 9}}
+	if !reflect.DeepEqual(plot.PointGroup["series.points.v.p99"].castedData, expected) {
+		t.Error("Unexpected data ", plot.PointGroup["series.points.v.p99"].castedData)
+	}
+	if plot.AddFromJSON(strings.NewReader(payload), "", "series.points.v") == nil {
+		t.Error("Expected an error for a path ending at objects.")
+	}
+}
@@ -0,0 +1,76 @@
+package glot
+
+import "fmt"
+
+// BorderSides are the sides of the graph a border is drawn on, see SetBorder.
+// They are combined with |, like BorderLeft | BorderBottom.
+type BorderSides int
+
+// The sides of the border.
+const (
+	BorderNone   BorderSides = 0
+	BorderBottom BorderSides = 1
+	BorderLeft   BorderSides = 2
+	BorderTop    BorderSides = 4
+	BorderRight  BorderSides = 8
+	BorderAll    BorderSides = BorderBottom | BorderLeft | BorderTop | BorderRight
+)
+
+// SetMargins places the edges of the graph at fixed distances from the edges of the image,
+// as fractions of its width and height, so that the graphs of several images line up.
+// A negative margin lets gnuplot compute it again.
+//
+// Usage
+//  plot.SetMargins(0.1, 0.05, 0.08, 0.12)
+func (plot *Plot) SetMargins(left, right, top, bottom float64) error {
+	margins := []struct {
+		name     string
+		position float64
+	}{
+		{"lmargin", left},
+		{"rmargin", 1 - right},
+		{"tmargin", 1 - top},
+		{"bmargin", bottom},
+	}
+	for _, margin := range margins {
+		var err error
+		if margin.position < 0 || margin.position > 1 {
+			err = plot.set(margin.name, "set %s -1", margin.name)
+		} else {
+			err = plot.set(margin.name, "set %s at screen %v", margin.name, margin.position)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetBorder draws the border of the graph on the given sides only.
+//
+// Usage
+//  plot.SetBorder(glot.BorderLeft | glot.BorderBottom)
+//  plot.SetBorder(glot.BorderNone)
+func (plot *Plot) SetBorder(sides BorderSides) error {
+	if sides < 0 || sides > BorderAll {
+		return &gnuplotError{err: fmt.Sprintf("The border sides %d are not a combination of BorderBottom, BorderLeft, BorderTop and BorderRight.", sides)}
+	}
+	if sides == BorderNone {
+		return plot.set("border", "unset border")
+	}
+	return plot.set("border", "set border %d", sides)
+}
+
+// SetAspectRatio fixes the ratio of the height to the width of the graph,
+// 1 makes it square. A negative ratio relates the lengths of the units of the axes instead,
+// -1 draws a unit of the y-axis as long as a unit of the x-axis. 0 lets the graph fill the image.
+//
+// Usage
+//  plot.SetAspectRatio(1)
+//  plot.SetAspectRatio(9.0 / 16)
+func (plot *Plot) SetAspectRatio(ratio float64) error {
+	if ratio == 0 {
+		return plot.set("size ratio", "set size noratio")
+	}
+	return plot.set("size ratio", "set size ratio %v", ratio)
+}
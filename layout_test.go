@@ -0,0 +1,29 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestSetMargins(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetMargins(0.1, 0.05, -1, 0.2)
+	expected := []string{"set lmargin at screen 0.1", "set rmargin at screen 0.95", "set tmargin -1", "set bmargin at screen 0.2"}
+	for i, cmd := range expected {
+		if plot.options[i].cmd != cmd {
+			t.Errorf("Expected %q, got %q", cmd, plot.options[i].cmd)
+		}
+	}
+}
+
+func TestSetBorder(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetBorder(BorderLeft | BorderBottom)
+	if plot.options[0].cmd != "set border 3" {
+		t.Error("Unexpected command ", plot.options[0].cmd)
+	}
+	if plot.SetBorder(BorderSides(16)) == nil {
+		t.Error("Expected an error for unknown sides")
+	}
+}
@@ -0,0 +1,37 @@
+package glot
+
+import "testing"
+
+func TestSetLegend(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	tests := []struct {
+		options LegendOptions
+		cmd     string
+	}{
+		{LegendOptions{}, "set key inside nobox noreverse"},
+		{LegendOptions{Position: "bottom right", Box: true}, "set key inside bottom right box noreverse"},
+		{LegendOptions{Outside: true, Position: "top center", Columns: 3}, "set key outside top center nobox horizontal maxcols 3 noreverse"},
+		{LegendOptions{Font: "Helvetica,10", Reverse: true}, `set key inside nobox font "Helvetica,10" reverse`},
+		{LegendOptions{Font: `Say "Hi",10`}, `set key inside nobox font "Say \"Hi\",10" noreverse`},
+		{LegendOptions{Hide: true, Box: true}, "unset key"},
+	}
+	for _, test := range tests {
+		err := plot.SetLegend(test.options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmd := lastCmd(plot); cmd != test.cmd {
+			t.Errorf("SetLegend(%+v) sent %q, expected %q", test.options, cmd, test.cmd)
+		}
+	}
+	n := 0
+	for _, option := range plot.options {
+		if option.key == "key" {
+			n++
+		}
+	}
+	if n != 1 {
+		t.Error("Expected a single key setting to be kept, got ", plot.options)
+	}
+}
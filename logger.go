@@ -0,0 +1,112 @@
+package glot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LogLevel is the importance of a message logged by a plot, see SetLogger.
+type LogLevel int
+
+// The levels of the messages.
+const (
+	LogDebug LogLevel = iota // The commands sent to gnuplot and the data files created and removed
+	LogInfo                  // The gnuplot processes started and stopped
+	LogWarn                  // The errors and warnings printed by gnuplot and restarts of gnuplot
+)
+
+func (level LogLevel) String() string {
+	switch level {
+	case LogDebug:
+		return "DEBUG"
+	case LogInfo:
+		return "INFO"
+	case LogWarn:
+		return "WARN"
+	}
+	return fmt.Sprintf("LogLevel(%d)", int(level))
+}
+
+// Logger receives the messages of a plot with alternating keys and values giving
+// their details, like "cmd", "set grid", in the style of log/slog.
+type Logger interface {
+	Log(level LogLevel, msg string, keyvals ...interface{})
+}
+
+// LoggerFunc adapts a function to the Logger interface.
+//
+// Usage
+//  plot.SetLogger(glot.LoggerFunc(func(level glot.LogLevel, msg string, keyvals ...interface{}) {
+//  	slog.Log(context.Background(), slog.Level(4*(int(level)-1)), msg, keyvals...)
+//  }))
+type LoggerFunc func(level LogLevel, msg string, keyvals ...interface{})
+
+// Log calls f.
+func (f LoggerFunc) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	f(level, msg, keyvals...)
+}
+
+// printLogger is the logger of the plots made in debug mode, it prints all messages to the standard output.
+type printLogger struct{}
+
+func (printLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	if msg == "command" && len(keyvals) >= 2 {
+		fmt.Printf("cmd> %v\n", keyvals[1])
+		return
+	}
+	details := make([]string, 0, len(keyvals)/2)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		details = append(details, fmt.Sprintf("%v=%v", keyvals[i], keyvals[i+1]))
+	}
+	fmt.Printf("** %v %s %s\n", level, msg, strings.Join(details, " "))
+}
+
+// debugLogger returns the logger of a plot made with the debug flag.
+func debugLogger(debug bool) Logger {
+	if debug {
+		return printLogger{}
+	}
+	return nil
+}
+
+// SetLogger sends the messages of the plot to logger instead of printing them in debug mode,
+// so that they end up with the other logs of the application. A nil logger turns logging off.
+//
+// Usage
+//  plot.SetLogger(glot.LoggerFunc(func(level glot.LogLevel, msg string, keyvals ...interface{}) {
+//  	if level >= glot.LogWarn {
+//  		log.Println(append([]interface{}{"glot:", msg}, keyvals...)...)
+//  	}
+//  }))
+func (plot *Plot) SetLogger(logger Logger) {
+	plot.logger.Store(loggerBox{logger})
+	plot.mu.Lock()
+	proc := plot.proc
+	plot.mu.Unlock()
+	if proc != nil && proc.errlog != nil {
+		proc.errlog.setLogger(logger)
+	}
+}
+
+// WithLogger sets the logger of the plot, see SetLogger.
+func WithLogger(logger Logger) Option {
+	return func(o *plotOptions) { o.logger = logger }
+}
+
+// loggerBox holds a Logger in an atomic.Value, which can't hold nil or different types.
+type loggerBox struct {
+	Logger
+}
+
+// log sends a message to the logger of the plot, if it has one.
+func (plot *Plot) log(level LogLevel, msg string, keyvals ...interface{}) {
+	if box, ok := plot.logger.Load().(loggerBox); ok && box.Logger != nil {
+		box.Log(level, msg, keyvals...)
+	}
+}
+
+// getLogger returns the logger of the plot, nil when it has none.
+func (plot *Plot) getLogger() Logger {
+	box, _ := plot.logger.Load().(loggerBox)
+	return box.Logger
+}
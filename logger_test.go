@@ -0,0 +1,39 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestSetLogger(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	var messages []string
+	plot.SetLogger(LoggerFunc(func(level LogLevel, msg string, keyvals ...interface{}) {
+		messages = append(messages, level.String()+" "+msg)
+	}))
+	plot.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	plot.Close()
+	expected := []string{"DEBUG data file created", "DEBUG command", "INFO gnuplot stopped", "DEBUG data file removed"}
+	if len(messages) != len(expected) {
+		t.Fatal("Unexpected messages ", messages)
+	}
+	for i := range expected {
+		if messages[i] != expected[i] {
+			t.Error("Unexpected messages ", messages)
+			break
+		}
+	}
+}
+
+func TestSetFormatLogsInvalidFormat(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	var messages []string
+	plot.SetLogger(LoggerFunc(func(level LogLevel, msg string, keyvals ...interface{}) {
+		messages = append(messages, level.String()+" "+msg)
+	}))
+	if plot.SetFormat("tls") == nil {
+		t.Error("Expected an error for an invalid format.")
+	}
+	if len(messages) != 1 || messages[0] != "WARN format not allowed" {
+		t.Error("Unexpected messages ", messages)
+	}
+}
@@ -0,0 +1,73 @@
+package glot
+
+// NewLogLogPlot makes a 2-d plot with logarithmic x and y axes, labeled with powers of ten
+// and with minor tics between them.
+//
+// Usage
+//  plot, _ := glot.NewLogLogPlot(false, false)
+//  plot.AddPointGroup("Power law", "lines", [][]float64{x, y})
+//  plot.SavePlot("loglog.png")
+func NewLogLogPlot(persist, debug bool) (*Plot, error) {
+	plot, err := NewPlot(2, persist, debug)
+	if err != nil {
+		return nil, err
+	}
+	for _, axis := range []string{"x", "y"} {
+		err = plot.setLogAxis(axis)
+		if err != nil {
+			plot.Close()
+			return nil, err
+		}
+	}
+	return plot, nil
+}
+
+// SetSemiLogX makes the x-axis logarithmic and the y-axis linear.
+//
+// Usage
+//  plot.SetSemiLogX()
+func (plot *Plot) SetSemiLogX() error {
+	err := plot.setLogAxis("x")
+	if err != nil {
+		return err
+	}
+	return plot.setLinearAxis("y")
+}
+
+// SetSemiLogY makes the y-axis logarithmic and the x-axis linear, for exponential growth or decay.
+//
+// Usage
+//  plot.SetSemiLogY()
+func (plot *Plot) SetSemiLogY() error {
+	err := plot.setLogAxis("y")
+	if err != nil {
+		return err
+	}
+	return plot.setLinearAxis("x")
+}
+
+// setLogAxis makes an axis logarithmic with base 10, with tic labels like 10³ and 9 minor tics per decade.
+func (plot *Plot) setLogAxis(axis string) error {
+	err := plot.SetLogScale(axis, 10)
+	if err != nil {
+		return err
+	}
+	err = plot.set("format "+axis, "set format %s \"10^{%%L}\"", axis)
+	if err != nil {
+		return err
+	}
+	return plot.set("m"+axis+"tics", "set m%stics 10", axis)
+}
+
+// setLinearAxis undoes setLogAxis.
+func (plot *Plot) setLinearAxis(axis string) error {
+	err := plot.set("logscale "+axis, "unset logscale %s", axis)
+	if err != nil {
+		return err
+	}
+	err = plot.set("format "+axis, "set format %s \"%% h\"", axis)
+	if err != nil {
+		return err
+	}
+	return plot.set("m"+axis+"tics", "set m%stics default", axis)
+}
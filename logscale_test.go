@@ -0,0 +1,26 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestNewLogLogPlot(t *testing.T) {
+	plot, err := NewLogLogPlot(false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	plot.SetSemiLogY()
+	expected := map[string]string{
+		"logscale x": "unset logscale x",
+		"format x":   `set format x "% h"`,
+		"logscale y": "set logscale y 10",
+		"format y":   `set format y "10^{%L}"`,
+		"mytics":     "set mytics 10",
+	}
+	for _, option := range plot.options {
+		if cmd, exists := expected[option.key]; exists && cmd != option.cmd {
+			t.Errorf("Expected %q, got %q", cmd, option.cmd)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package glot
+
+import (
+	"expvar"
+	"fmt"
+	"math"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// Sampler is a value sampled over time by MonitorRuntime, like the size of the heap.
+type Sampler struct {
+	Name   string         // Name of the PointGroup of the samples
+	Sample func() float64 // Returns the current value
+}
+
+// HeapAlloc samples the bytes of allocated heap objects in MiB.
+func HeapAlloc() Sampler {
+	return Sampler{Name: "heap (MiB)", Sample: func() float64 {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		return float64(stats.HeapAlloc) / (1 << 20)
+	}}
+}
+
+// Goroutines samples the number of goroutines.
+func Goroutines() Sampler {
+	return Sampler{Name: "goroutines", Sample: func() float64 {
+		return float64(runtime.NumGoroutine())
+	}}
+}
+
+// GCPause samples the duration of the last garbage collection pause in milliseconds.
+func GCPause() Sampler {
+	return Sampler{Name: "GC pause (ms)", Sample: func() float64 {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		if stats.NumGC == 0 {
+			return 0
+		}
+		return float64(stats.PauseNs[(stats.NumGC+255)%256]) / 1e6
+	}}
+}
+
+// Expvar samples the published expvar variable with the given name, which must be a number,
+// like an *expvar.Int or *expvar.Float, or a Func returning one. Missing variables are sampled as NaN.
+func Expvar(name string) Sampler {
+	return Sampler{Name: name, Sample: func() float64 {
+		v := expvar.Get(name)
+		if v == nil {
+			return math.NaN()
+		}
+		value, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return math.NaN()
+		}
+		return value
+	}}
+}
+
+// MonitorRuntime samples the values on every interval and appends them to a line each,
+// with the seconds since the start on the x-axis, redrawing the plot after every sample,
+// until StopRefresh is called. The plot must be 2-d. It replaces a refresh loop
+// started by StartRefresh.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, true, false)
+//  plot.MonitorRuntime(time.Second, glot.HeapAlloc(), glot.Goroutines(), glot.Expvar("requests"))
+//  defer plot.StopRefresh()
+func (plot *Plot) MonitorRuntime(interval time.Duration, samplers ...Sampler) error {
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("MonitorRuntime needs a 2-d plot, not a %d-d plot.", plot.dimensions), kind: ErrInvalidDimensions}
+	}
+	if len(samplers) == 0 {
+		return &gnuplotError{err: "MonitorRuntime needs at least one sampler."}
+	}
+	start := time.Now()
+	for _, sampler := range samplers {
+		err := plot.AddPointGroup(sampler.Name, "lines", [][]float64{{0}, {sampler.Sample()}})
+		if err != nil {
+			return err
+		}
+	}
+	plot.every(interval, func() {
+		x := time.Since(start).Seconds()
+		for _, sampler := range samplers {
+			plot.AppendXY(sampler.Name, x, sampler.Sample())
+		}
+		plot.Refresh()
+	})
+	return nil
+}
@@ -0,0 +1,32 @@
+package glot
+
+import (
+	"expvar"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMonitorRuntime(t *testing.T) {
+	expvar.NewInt("glot_test_requests").Set(7)
+	if v := Expvar("glot_test_requests").Sample(); v != 7 {
+		t.Error("Expected the value of the expvar variable, got ", v)
+	}
+	if v := Expvar("glot_test_missing").Sample(); !math.IsNaN(v) {
+		t.Error("Expected NaN for a missing variable, got ", v)
+	}
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	err := plot.MonitorRuntime(time.Millisecond, Goroutines(), Expvar("glot_test_requests"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	plot.StopRefresh()
+	plot.mu.Lock()
+	samples := len(plot.PointGroup["glot_test_requests"].castedData.([][]float64)[0])
+	plot.mu.Unlock()
+	if samples < 2 {
+		t.Error("Expected the values to be sampled, got ", samples)
+	}
+}
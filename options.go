@@ -0,0 +1,123 @@
+package glot
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// Option configures a plot made by NewPlotWithOptions.
+type Option func(*plotOptions)
+
+type plotOptions struct {
+	dimensions int
+	persist    bool
+	debug      bool
+	format     string
+	tempDir    string
+	gnuplot    string
+	headless   bool
+	logger     Logger
+	dryRun     bool
+	plotter    Plotter
+	stable     bool
+	inline     bool
+}
+
+// WithDimensions sets the dimensions of the plot, 2 by default.
+func WithDimensions(dimensions int) Option {
+	return func(o *plotOptions) { o.dimensions = dimensions }
+}
+
+// WithPersist makes the gnuplot window stay open after the plot is closed.
+func WithPersist(persist bool) Option {
+	return func(o *plotOptions) { o.persist = persist }
+}
+
+// WithDebug prints the commands sent to gnuplot.
+func WithDebug(debug bool) Option {
+	return func(o *plotOptions) { o.debug = debug }
+}
+
+// WithFormat sets the format the plot is saved in, see SetFormat.
+func WithFormat(format string) Option {
+	return func(o *plotOptions) { o.format = format }
+}
+
+// WithTempDir sets the directory of the data files, see SetTempDir.
+func WithTempDir(dir string) Option {
+	return func(o *plotOptions) { o.tempDir = dir }
+}
+
+// WithGnuplotPath sets the gnuplot binary run by the plot instead of the one set by SetGnuplotPath.
+func WithGnuplotPath(path string) Option {
+	return func(o *plotOptions) { o.gnuplot = path }
+}
+
+// NewPlotWithOptions makes a new plot configured by the options.
+// Unlike NewPlot the settings are named at the call site and new ones can be added.
+//
+// Usage
+//  plot, err := glot.NewPlotWithOptions(
+//  	glot.WithDimensions(3),
+//  	glot.WithFormat("svg"),
+//  	glot.WithTempDir("/dev/shm"),
+//  )
+func NewPlotWithOptions(opts ...Option) (*Plot, error) {
+	o := plotOptions{dimensions: 2, format: "png", gnuplot: gGnuplotCmd}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	plot, err := newPlot(context.Background(), o.dimensions, o.debug)
+	if err != nil {
+		return nil, err
+	}
+	if !isAllowedFormat(o.format) {
+		return nil, &gnuplotError{err: fmt.Sprintf("invalid format '%s'", o.format)}
+	}
+	plot.format = o.format
+	plot.gnuplot = o.gnuplot
+	err = plot.SetTempDir(o.tempDir)
+	if err != nil {
+		return nil, err
+	}
+	if o.inline {
+		err = plot.SetInlineData(true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if o.logger != nil {
+		plot.SetLogger(o.logger)
+	}
+	if o.stable {
+		err = plot.requireFeature("default-settings")
+		if err != nil {
+			return nil, err
+		}
+		plot.stable = true
+		plot.terminal = DeterministicTerminal
+	}
+	if o.plotter != nil {
+		plot.plotter = o.plotter
+	} else if o.dryRun {
+		plot.dryRun = true
+	} else {
+		gnuplot, err := exec.LookPath(o.gnuplot)
+		if o.gnuplot == "" || err != nil {
+			return nil, &gnuplotError{err: fmt.Sprintf("could not use %q as gnuplot", o.gnuplot), kind: ErrGnuplotNotFound}
+		}
+		plot.proc, err = newPlotterProc(plot.ctx, gnuplot, o.persist && !o.headless, plot.gnuplotArgs()...)
+		if err != nil {
+			return nil, err
+		}
+		plot.setFinalizer()
+	}
+	if o.headless {
+		err = plot.SetHeadless()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plot, nil
+}
@@ -0,0 +1,21 @@
+package glot
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPlotWithOptions(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDimensions(3), WithFormat("svg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plot.dimensions != 3 || plot.format != "svg" {
+		t.Error("Expected the options to be applied")
+	}
+	plot.Close()
+	_, err = NewPlotWithOptions(WithGnuplotPath("/nonexistent/gnuplot"))
+	if !errors.Is(err, ErrGnuplotNotFound) {
+		t.Error("Expected ErrGnuplotNotFound, got ", err)
+	}
+}
@@ -0,0 +1,61 @@
+package glot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PaletteStop is a color of a palette gradient at a position from 0 to 1.
+type PaletteStop struct {
+	Position float64
+	Color    string // A hex value like "#ff0000" or a name like "red"
+}
+
+// palettes are the named palettes of SetPalette, sampled at evenly spaced stops.
+var palettes = map[string][]string{
+	"viridis":   {"#440154", "#472c7a", "#3b518b", "#2c718e", "#21908d", "#27ad81", "#5cc863", "#aadc32", "#fde725"},
+	"magma":     {"#000004", "#1c1044", "#4f127b", "#812581", "#b5367a", "#e55064", "#fb8761", "#fec287", "#fcfdbf"},
+	"inferno":   {"#000004", "#1f0c48", "#550f6d", "#88226a", "#ba3655", "#e35933", "#f98e09", "#f9cb35", "#fcffa4"},
+	"plasma":    {"#0d0887", "#4c02a1", "#7e03a8", "#a92395", "#cc4778", "#e66c5c", "#f89540", "#fdc527", "#f0f921"},
+	"cubehelix": {"#000000", "#1a1530", "#163d4e", "#1f6642", "#54792f", "#a07949", "#d07e93", "#cf9cda", "#c1caf3", "#ffffff"},
+	"grayscale": {"#000000", "#ffffff"},
+}
+
+// SetPalette sets the palette used by heatmaps, pm3d surfaces and palette colored points
+// to one of viridis, magma, inferno, plasma, cubehelix or grayscale.
+//
+// Usage
+//  plot.SetPalette("viridis")
+//  plot.AddPointGroup("Heat", "heatmap", matrix)
+func (plot *Plot) SetPalette(name string) error {
+	colors, exists := palettes[name]
+	if !exists {
+		names := make([]string, 0, len(palettes))
+		for name := range palettes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return &gnuplotError{err: fmt.Sprintf("unknown palette '%s', use one of %s", name, strings.Join(names, ", "))}
+	}
+	stops := make([]PaletteStop, len(colors))
+	for i, color := range colors {
+		stops[i] = PaletteStop{Position: float64(i) / float64(len(colors)-1), Color: color}
+	}
+	return plot.SetPaletteStops(stops...)
+}
+
+// SetPaletteStops sets a custom palette that goes through the colors of the stops.
+//
+// Usage
+//  plot.SetPaletteStops(glot.PaletteStop{0, "blue"}, glot.PaletteStop{0.5, "white"}, glot.PaletteStop{1, "red"})
+func (plot *Plot) SetPaletteStops(stops ...PaletteStop) error {
+	if len(stops) < 2 {
+		return &gnuplotError{err: fmt.Sprintf("a palette needs at least 2 stops")}
+	}
+	defined := make([]string, len(stops))
+	for i, stop := range stops {
+		defined[i] = fmt.Sprintf("%v %s", stop.Position, quote(stop.Color))
+	}
+	return plot.set("palette", "set palette defined (%s)", strings.Join(defined, ", "))
+}
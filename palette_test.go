@@ -0,0 +1,14 @@
+package glot
+
+import "testing"
+
+func TestSetPalette(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	plot.SetPalette("grayscale")
+	if plot.options[0].cmd != `set palette defined (0 "#000000", 1 "#ffffff")` {
+		t.Error("Unexpected palette ", plot.options[0].cmd)
+	}
+	if plot.SetPalette("jet") == nil {
+		t.Error("Expected an error for an unknown palette.")
+	}
+}
@@ -0,0 +1,152 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Normalization is how the values of an axis of parallel coordinates are scaled,
+// see AddParallelCoordinates.
+type Normalization int
+
+// The normalizations of the axes.
+const (
+	NormalizeNone   Normalization = iota // The values as they are, the axis autoscaled by gnuplot
+	NormalizeMinMax                      // Scaled to 0 for the smallest and 1 for the largest value
+	NormalizeZScore                      // Standardized to a mean of 0 and a standard deviation of 1
+)
+
+// AddParallelCoordinates draws every row as a line across parallel vertical axes, one per
+// label and column of the rows, to compare many variables of multivariate data at once.
+// Each axis has a range of its own. The normalizations apply to the axes in order,
+// a single one applies to all of them, and the normalized axes share a common range.
+// It uses gnuplot's parallelaxes style, which requires gnuplot 5.2.
+//
+// Usage
+//  labels := []string{"mpg", "cylinders", "horsepower", "weight"}
+//  plot.AddParallelCoordinates(labels, cars, glot.NormalizeMinMax)
+func (plot *Plot) AddParallelCoordinates(labels []string, rows [][]float64, normalize ...Normalization) error {
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("Parallel coordinates must be 2-d plots."), kind: ErrInvalidDimensions}
+	}
+	if len(labels) < 2 {
+		return &gnuplotError{err: fmt.Sprintf("Parallel coordinates need at least 2 axes, got %d.", len(labels))}
+	}
+	for i, row := range rows {
+		if len(row) != len(labels) {
+			return &gnuplotError{err: fmt.Sprintf("Row %d has %d values for %d axes.", i, len(row), len(labels)), kind: ErrLengthMismatch}
+		}
+	}
+	if len(normalize) != 0 && len(normalize) != 1 && len(normalize) != len(labels) {
+		return &gnuplotError{err: fmt.Sprintf("%d normalizations for %d axes.", len(normalize), len(labels)), kind: ErrLengthMismatch}
+	}
+	err := plot.requireFeature("parallelaxes")
+	if err != nil {
+		return err
+	}
+
+	axes := len(labels)
+	normalizations := make([]Normalization, axes)
+	for i := range normalizations {
+		switch len(normalize) {
+		case 1:
+			normalizations[i] = normalize[0]
+		case axes:
+			normalizations[i] = normalize[i]
+		}
+	}
+	scaled, common := normalizeColumns(rows, normalizations)
+
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	const name = "parallel coordinates"
+	if _, exists := plot.PointGroup[name]; exists {
+		return &gnuplotError{err: fmt.Sprintf("The plot already has parallel coordinates."), kind: ErrDuplicatePointGroup}
+	}
+	tics := make([]string, axes)
+	for i, label := range labels {
+		tics[i] = fmt.Sprintf("%s %d", plot.text(label), i+1)
+	}
+	commands := []setting{{"border", "unset border"}, {"ytics", "unset ytics"}, {"key", "unset key"},
+		{"xtics", fmt.Sprintf("set xtics (%s) scale 0,0 nomirror", strings.Join(tics, ", "))},
+		{"xrange", fmt.Sprintf("set xrange [0.8:%v]", float64(axes)+0.2)}}
+	for i, normalization := range normalizations {
+		axis := fmt.Sprintf("paxis %d", i+1)
+		commands = append(commands, setting{axis + " tics", fmt.Sprintf("set %s tics", axis)})
+		if normalization != NormalizeNone && common.n > 0 {
+			commands = append(commands, setting{axis + " range", fmt.Sprintf("set %s range [%v:%v]", axis, common.min, common.max)})
+		}
+	}
+	for _, command := range commands {
+		plot.keepSetting(command.key, command.cmd)
+		err := plot.Cmd("%s", command.cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	curve := &PointGroup{name: name, dimensions: 2, style: "parallelaxes", set: true, plot: plot}
+	f, err := plot.dataFile(curve)
+	if err != nil {
+		return err
+	}
+	values := make([]string, axes)
+	for _, row := range scaled {
+		for i, v := range row {
+			values[i] = fmt.Sprint(v)
+		}
+		f.WriteString(strings.Join(values, " ") + "\n")
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	err = plot.sendPlotLine(curve, fmt.Sprintf("%s for [glot_i=1:%d] %s using glot_i notitle with parallelaxes", cmd, axes, quote(f.Name())))
+	plot.PointGroup[name] = curve
+	return err
+}
+
+// normalizeColumns returns the rows with their columns normalized,
+// and the extent of the values of the normalized columns.
+func normalizeColumns(rows [][]float64, normalizations []Normalization) ([][]float64, extent) {
+	scaled := make([][]float64, len(rows))
+	for i, row := range rows {
+		scaled[i] = append([]float64{}, row...)
+	}
+	var common extent
+	for col, normalization := range normalizations {
+		if normalization == NormalizeNone {
+			continue
+		}
+		var e extent
+		sum, sumSq := 0.0, 0.0
+		for _, row := range rows {
+			e.add(row[col])
+			if !math.IsNaN(row[col]) {
+				sum += row[col]
+				sumSq += row[col] * row[col]
+			}
+		}
+		if e.empty() {
+			continue
+		}
+		offset, scale := e.min, e.max-e.min
+		if normalization == NormalizeZScore {
+			mean := sum / float64(e.n)
+			offset, scale = mean, math.Sqrt(math.Max(sumSq/float64(e.n)-mean*mean, 0))
+		}
+		if scale == 0 {
+			scale = 1
+		}
+		for _, row := range scaled {
+			row[col] = (row[col] - offset) / scale
+			common.add(row[col])
+		}
+	}
+	return scaled, common
+}
@@ -0,0 +1,32 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddParallelCoordinates(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	rows := [][]float64{{1, 10, 100}, {2, 30, 300}, {3, 20, 200}}
+	err := plot.AddParallelCoordinates([]string{"a", "b", "c"}, rows, NormalizeMinMax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := strings.Join(plot.PointGroup["parallel coordinates"].specs, ", ")
+	if !strings.Contains(spec, "for [glot_i=1:3]") || !strings.Contains(spec, "with parallelaxes") {
+		t.Error("Expected the rows to be plotted with parallelaxes, got ", spec)
+	}
+	if rows[1][1] != 30 {
+		t.Error("Expected the rows to stay as they are, got ", rows[1])
+	}
+	scaled, common := normalizeColumns(rows, []Normalization{NormalizeNone, NormalizeMinMax, NormalizeZScore})
+	if scaled[1][0] != 2 || scaled[1][1] != 1 || scaled[2][1] != 0.5 || scaled[2][2] != 0 {
+		t.Error("Expected the columns to be normalized, got ", scaled)
+	}
+	if common.min >= 0 || common.max <= 1 {
+		t.Error("Expected the range of the normalized columns, got ", common)
+	}
+	if plot.AddParallelCoordinates([]string{"a", "b"}, rows) == nil {
+		t.Error("Expected an error for rows longer than the labels.")
+	}
+}
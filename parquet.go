@@ -0,0 +1,107 @@
+//go:build parquet
+// +build parquet
+
+package glot
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// AddFromParquet adds a curve of the column yCol against the column xCol of a Parquet file,
+// named after yCol, in the style of the plot. Nested columns are given by their dotted path,
+// like "metrics.latency". Numeric and boolean columns are supported, missing values are left out.
+// It needs github.com/parquet-go/parquet-go and is only built with the parquet build tag.
+//
+// Usage
+//  plot.AddFromParquet("events.parquet", "timestamp", "latency")
+func (plot *Plot) AddFromParquet(path string, xCol, yCol string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	file, err := parquet.OpenFile(f, info.Size())
+	if err != nil {
+		return err
+	}
+	x, err := parquetColumn(file, xCol)
+	if err != nil {
+		return err
+	}
+	y, err := parquetColumn(file, yCol)
+	if err != nil {
+		return err
+	}
+	return plot.AddSeriesXY(yCol, "", x, y)
+}
+
+// parquetColumn reads a column of a Parquet file as float64 values, NaN for the missing ones.
+func parquetColumn(file *parquet.File, name string) ([]float64, error) {
+	leaf, exists := file.Schema().Lookup(strings.Split(name, ".")...)
+	if !exists {
+		return nil, &gnuplotError{err: fmt.Sprintf("The Parquet file has no column %s.", name)}
+	}
+	var column []float64
+	for _, rowGroup := range file.RowGroups() {
+		pages := rowGroup.ColumnChunks()[leaf.ColumnIndex].Pages()
+		for {
+			page, err := pages.ReadPage()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				pages.Close()
+				return nil, err
+			}
+			values := make([]parquet.Value, page.NumValues())
+			n, err := page.Values().ReadValues(values)
+			if err != nil && err != io.EOF {
+				pages.Close()
+				return nil, err
+			}
+			for _, value := range values[:n] {
+				v, err := parquetNumber(value)
+				if err != nil {
+					pages.Close()
+					return nil, &gnuplotError{err: fmt.Sprintf("The column %s is not numeric: %v", name, err), kind: ErrUnsupportedDataType}
+				}
+				column = append(column, v)
+			}
+		}
+		pages.Close()
+	}
+	return column, nil
+}
+
+// parquetNumber converts a Parquet value to a float64.
+func parquetNumber(value parquet.Value) (float64, error) {
+	if value.IsNull() {
+		return math.NaN(), nil
+	}
+	switch value.Kind() {
+	case parquet.Boolean:
+		if value.Boolean() {
+			return 1, nil
+		}
+		return 0, nil
+	case parquet.Int32:
+		return float64(value.Int32()), nil
+	case parquet.Int64:
+		return float64(value.Int64()), nil
+	case parquet.Float:
+		return float64(value.Float()), nil
+	case parquet.Double:
+		return value.Double(), nil
+	}
+	return 0, fmt.Errorf("values of kind %v", value.Kind())
+}
@@ -0,0 +1,42 @@
+//go:build parquet
+// +build parquet
+
+package glot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+func TestAddFromParquet(t *testing.T) {
+	type row struct {
+		Time    int64   `parquet:"time"`
+		Latency float64 `parquet:"latency"`
+		Host    string  `parquet:"host"`
+	}
+	dir, _ := ioutil.TempDir("", "glot")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.parquet")
+	err := parquet.WriteFile(path, []row{{1, 0.5, "a"}, {2, 0.7, "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	err = plot.AddFromParquet(path, "time", "latency")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]float64{{1, 2}, {0.5, 0.7}}
+	if !reflect.DeepEqual(plot.PointGroup["latency"].castedData, expected) {
+		t.Error("Unexpected data ", plot.PointGroup["latency"].castedData)
+	}
+	if plot.AddFromParquet(path, "time", "host") == nil {
+		t.Error("Expected an error for a column of strings.")
+	}
+}
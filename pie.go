@@ -0,0 +1,107 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+)
+
+// PieOptions controls how AddPieChart draws a pie chart.
+type PieOptions struct {
+	Colors      []string // Colors of the slices, repeated when there are more slices, a default palette when nil
+	Percentages bool     // Add the percentage of every slice to its label
+	Hole        float64  // Radius of the hole of a donut chart, relative to the radius of the pie, 0 for a pie
+	StartAngle  float64  // Angle of the start of the first slice in degrees, counterclockwise from the positive x-axis
+}
+
+// pieColors is the default palette of pie charts.
+var pieColors = []string{"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd",
+	"#8c564b", "#e377c2", "#7f7f7f", "#bcbd22", "#17becf"}
+
+// nextTag returns a new tag for a gnuplot object or label of the plot.
+func (plot *Plot) nextTag() int {
+	plot.tags++
+	return plot.tags
+}
+
+// AddPieChart draws a pie chart of the values, with a slice and a label per value.
+// gnuplot has no pie charts, so the slices are drawn as circle objects on an
+// otherwise empty 2-d plot, whose axes are hidden.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddPieChart([]string{"Go", "Rust", "C"}, []float64{60, 25, 15}, glot.PieOptions{Percentages: true, Hole: 0.4})
+//  plot.SavePlot("pie.png")
+func (plot *Plot) AddPieChart(labels []string, values []float64, opts PieOptions) error {
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("Pie charts must be 2-d plots."), kind: ErrInvalidDimensions}
+	}
+	if len(labels) != len(values) {
+		return &gnuplotError{err: fmt.Sprintf("The number of labels and values are not same.")}
+	}
+	total := 0.0
+	for _, v := range values {
+		if v < 0 {
+			return &gnuplotError{err: fmt.Sprintf("A pie chart can't show the negative value %v.", v)}
+		}
+		total += v
+	}
+	if total == 0 {
+		return &gnuplotError{err: fmt.Sprintf("A pie chart needs at least one value above 0.")}
+	}
+	colors := opts.Colors
+	if len(colors) == 0 {
+		colors = pieColors
+	}
+
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	const name = "pie chart"
+	if _, exists := plot.PointGroup[name]; exists {
+		return &gnuplotError{err: fmt.Sprintf("The plot already has a pie chart."), kind: ErrDuplicatePointGroup}
+	}
+	commands := []setting{{"size", "set size square"}, {"border", "unset border"},
+		{"xtics", "unset xtics"}, {"ytics", "unset ytics"}, {"key", "unset key"},
+		{"xrange", "set xrange [-1.5:1.5]"}, {"yrange", "set yrange [-1.5:1.5]"}}
+	angle := opts.StartAngle
+	for i, v := range values {
+		sweep := 360 * v / total
+		tag := plot.nextTag()
+		commands = append(commands, setting{fmt.Sprintf("object %d", tag),
+			fmt.Sprintf("set object %d circle at 0,0 size 1 arc [%v:%v] fc rgb %s fs solid 1.0 noborder",
+				tag, angle, angle+sweep, quote(colors[i%len(colors)]))})
+		text := labels[i]
+		if opts.Percentages {
+			text = fmt.Sprintf("%s (%.1f%%)", text, 100*v/total)
+		}
+		middle := (angle + sweep/2) * math.Pi / 180
+		tag = plot.nextTag()
+		commands = append(commands, setting{fmt.Sprintf("label %d", tag),
+			fmt.Sprintf("set label %d %s at %.3f,%.3f center", tag, plot.text(text), 1.2*math.Cos(middle), 1.2*math.Sin(middle))})
+		angle += sweep
+	}
+	if opts.Hole > 0 {
+		tag := plot.nextTag()
+		commands = append(commands, setting{fmt.Sprintf("object %d", tag),
+			fmt.Sprintf("set object %d circle at 0,0 size %v fc rgb \"white\" fs solid 1.0 noborder front", tag, opts.Hole)})
+	}
+	for _, command := range commands {
+		plot.keepSetting(command.key, command.cmd)
+		err := plot.Cmd("%s", command.cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The objects are only drawn with a plot, so plot nothing.
+	curve := &PointGroup{name: name, dimensions: 2, style: "lines", set: true, plot: plot}
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	err := plot.sendPlotLine(curve, cmd+" 1/0 notitle")
+	plot.PointGroup[name] = curve
+	return err
+}
@@ -0,0 +1,17 @@
+package glot
+
+import "testing"
+
+func TestAddPieChart(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddPieChart([]string{"Go", "C"}, []float64{3, 1}, PieOptions{Percentages: true, Hole: 0.4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plot.tags != 5 {
+		t.Error("Expected 2 slices, 2 labels and a hole, got ", plot.tags)
+	}
+	if plot.AddPieChart([]string{"Go"}, []float64{-1}, PieOptions{}) == nil {
+		t.Error("Expected an error for a negative value.")
+	}
+}
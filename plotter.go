@@ -0,0 +1,82 @@
+package glot
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Plotter is what a plot sends its commands to, normally a gnuplot process.
+// Another Plotter can be given to a plot with WithPlotter, like a FakePlotter in tests.
+type Plotter interface {
+	// SendCommand sends a command, or several separated by newlines, without a trailing newline.
+	SendCommand(cmd string) error
+	// Close is called once by Close of the plot.
+	Close() error
+}
+
+// SendCommand sends a command to gnuplot.
+func (proc *plotterProcess) SendCommand(cmd string) error {
+	_, err := proc.write(cmd + "\n")
+	return err
+}
+
+// Close closes the input of gnuplot and waits for it to exit.
+func (proc *plotterProcess) Close() error {
+	return proc.wait()
+}
+
+// WithPlotter makes the plot send its commands to the plotter instead of a gnuplot process.
+// Images that are drawn by a gnuplot process of their own, like with Render or insets,
+// are sent to the plotter too.
+//
+// Usage
+//  fake := &glot.FakePlotter{}
+//  plot, _ := glot.NewPlotWithOptions(glot.WithPlotter(fake))
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  fmt.Println(fake.Commands())
+func WithPlotter(plotter Plotter) Option {
+	return func(o *plotOptions) { o.plotter = plotter }
+}
+
+// FakePlotter is a Plotter that keeps the commands in memory, for unit tests
+// of code that builds plots. The zero value is ready to use.
+type FakePlotter struct {
+	Err error // Returned by SendCommand when it is not nil
+
+	mu       sync.Mutex
+	commands []string
+	closed   bool
+}
+
+// SendCommand records the command and returns Err.
+func (fake *FakePlotter) SendCommand(cmd string) error {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.closed {
+		return &gnuplotError{err: fmt.Sprintf("command %q sent to a closed plotter", cmd)}
+	}
+	fake.commands = append(fake.commands, cmd)
+	return fake.Err
+}
+
+// Close marks the plotter as closed.
+func (fake *FakePlotter) Close() error {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.closed = true
+	return nil
+}
+
+// Commands returns the commands sent so far, in order.
+func (fake *FakePlotter) Commands() []string {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return append([]string{}, fake.commands...)
+}
+
+// Closed reports whether Close was called.
+func (fake *FakePlotter) Closed() bool {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return fake.closed
+}
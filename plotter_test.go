@@ -0,0 +1,28 @@
+package glot
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFakePlotter(t *testing.T) {
+	fake := &FakePlotter{}
+	plot, err := NewPlotWithOptions(WithPlotter(fake))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plot.SetTitle("Test Results")
+	commands := fake.Commands()
+	if len(commands) != 1 || commands[0] != `set title "Test Results"` {
+		t.Errorf("commands = %q", commands)
+	}
+
+	fake.Err = errors.New("broken pipe")
+	if err := plot.SetTitle("Again"); err != fake.Err {
+		t.Errorf("SetTitle = %v, want %v", err, fake.Err)
+	}
+	plot.Close()
+	if !fake.Closed() {
+		t.Error("the plotter was not closed")
+	}
+}
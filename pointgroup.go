@@ -2,6 +2,8 @@ package glot
 
 import (
 	"fmt"
+	"strings"
+	"time"
 )
 
 // PointType ...
@@ -40,19 +42,93 @@ type PointGroup struct {
 	color      string      // Color of the curve/point
 	pointSize  float64     // Size of the point
 	pointType  PointType   // type of point, only apply in case of points
+	fname      string      // temporary file holding the plotted data
+	specs      []string    // plot command of the curve without the leading plot/splot/replot, a spec per plotted data set
+	index      int         // position of the curve in the plot command
+	lineWidth  float64     // width of the line, gnuplot's default when 0
+	dashType   int         // dash type of the line, solid when 0
+	fillStyle  string      // fill style of boxes and areas
+	plot       *Plot       // the plot the curve belongs to
+	binary     bool        // the data file is in gnuplot's binary format
+	alpha      float64     // opacity of the curve from 0 to 1, opaque when 0
+	source     *PointGroup // the curve whose data file is drawn, see AddPointGroupFrom
+	smoothing  Smoothing   // how the curve is smoothed, see SetSmoothing
+	owned      bool        // castedData is a copy of the data that AppendPoint and friends may append to
 }
 
-// CandlesticksData ...
+// CandlesticksData holds the candles of a candlestick chart.
+// Candles[i] holds the open, high, low and close prices of candle i.
+// It is drawn with the candlesticks style, or as OHLC bars with the financebars style.
 type CandlesticksData struct {
-	XArray     []int64 // index of candle
-	Timestamps []int64
-	Candles    [][]float64
-	UpColor    string
-	DownColor  string
-	BoxWidth   float64
+	XArray      []int64 // index of candle
+	Timestamps  []int64 // Unix time of the candles, used for the x-axis instead of XArray when set
+	TimeFormat  string  // Format of the time on the x-axis, like "%Y-%m-%d" (the default)
+	Candles     [][]float64
+	Volume      []float64 // Volume of the candles, drawn as bars in the lower quarter of the graph when set
+	VolumeColor string    // Color of the volume bars, gray by default
+	UpColor     string
+	DownColor   string
+	WickColor   string // Color of the wicks, the color of the body by default
+	BorderColor string // Color of the border of the bodies, no border by default
+	BoxWidth    float64
+}
+
+// HeatmapData holds a matrix of values that is drawn as a heatmap.
+// Matrix[i][j] is the value of row i (y-axis) and column j (x-axis).
+type HeatmapData struct {
+	Matrix  [][]float64
+	Palette string  // gnuplot palette definition, e.g. "rgbformulae 33,13,10"
+	CBMin   float64 // lower bound of the color range
+	CBMax   float64 // upper bound of the color range, autoscaled when equal to CBMin
+}
+
+// TimeSeriesData holds points with times on the x-axis.
+// The times are taken from Time, or from Unix (seconds since the epoch) when Time is nil.
+// Format is the gnuplot time format of the x-axis tic labels, "%Y-%m-%d %H:%M" when empty.
+type TimeSeriesData struct {
+	Time   []time.Time
+	Unix   []int64
+	Y      []float64
+	Format string
+}
+
+// SurfaceData holds a grid of values for 3-d surface and mesh plots.
+// Z[i][j] is the height at x = X[j] and y = Y[i].
+type SurfaceData struct {
+	X []float64
+	Y []float64
+	Z [][]float64
+}
+
+// BoxPlotData holds groups of samples that are drawn as boxplots side by side.
+// gnuplot computes the quartiles of every group.
+type BoxPlotData struct {
+	Groups       [][]float64
+	Labels       []string // x-axis tic label of every group
+	WhiskerRange float64  // whiskers extend this many interquartile ranges, 1.5 when 0
+	HideOutliers bool     // don't draw the points outside of the whiskers
+}
+
+// ErrorBarData holds points together with their error values.
+// YErr and XErr are symmetric errors, YLow and YHigh give an asymmetric
+// y range and take precedence over YErr when set.
+// The style of the PointGroup selects which error columns are drawn:
+// "yerrorbars" (default), "xerrorbars" or "xyerrorbars".
+type ErrorBarData struct {
+	X     []float64
+	Y     []float64
+	XErr  []float64
+	YErr  []float64
+	YLow  []float64
+	YHigh []float64
 }
 
 // AddPointGroup function adds a group of points to a plot.
+// The data is a slice of any integer or float type, a slice holding one such slice
+// per dimension, a slice of structs with fields tagged glot:"x", glot:"y" and glot:"z",
+// or one of the data types of this package like CandlesticksData.
+// The shape of the data is only checked at run time, new code plotting plain
+// slices should prefer AddSeries1D, AddSeriesXY and AddSeriesXYZ.
 //
 // Usage
 //  dimensions := 2
@@ -66,6 +142,24 @@ func (plot *Plot) AddPointGroup(name string, style string, data interface{}) (er
 	return plot.AddPointGroupAdvance(name, style, 0.0, PointTypePlus, data)
 }
 
+// Add adds a group of points to a plot like AddPointGroup and returns the PointGroup,
+// so that it can be changed later without looking it up by name.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, false, false)
+//  curve, _ := plot.Add("Sample1", "lines", []float64{51, 8, 4, 11})
+//  curve.SetColor("#1f77b4")
+//  curve.UpdateData([]float64{52, 9, 3, 12})
+func (plot *Plot) Add(name string, style string, data interface{}) (*PointGroup, error) {
+	err := plot.AddPointGroup(name, style, data)
+	if err != nil {
+		return nil, err
+	}
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	return plot.PointGroup[name], nil
+}
+
 // AddPointGroupAdvance ...
 func (plot *Plot) AddPointGroupAdvance(
 	name string,
@@ -74,10 +168,12 @@ func (plot *Plot) AddPointGroupAdvance(
 	pointType PointType,
 	data interface{},
 ) (err error) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
 
 	_, exists := plot.PointGroup[name]
 	if exists {
-		return &gnuplotError{fmt.Sprintf("A PointGroup with the name %s  already exists, please use another name of the curve or remove this curve before using another one with the same name.", name)}
+		return &gnuplotError{err: fmt.Sprintf("A PointGroup with the name %s  already exists, please use another name of the curve or remove this curve before using another one with the same name.", name), kind: ErrDuplicatePointGroup}
 	}
 
 	curve := &PointGroup{
@@ -87,252 +183,75 @@ func (plot *Plot) AddPointGroupAdvance(
 		set:        true,
 		pointSize:  pointSize,
 		pointType:  pointType,
+		plot:       plot,
 	}
 
-	allowed := []string{
-		"lines", "points", "linepoints",
-		"impulses", "dots", "bar",
-		"steps", "fill solid", "histogram", "circle",
-		"errorbars", "boxerrorbars",
-		"boxes", "lp", "candlesticks"}
-	curve.style = defaultStyle
-	discovered := 0
-	for _, s := range allowed {
-		if s == style {
-			curve.style = style
-			err = nil
-			discovered = 1
+	curve.style = plot.style
+	if style != "" {
+		err = plot.checkStyle(style)
+		if err != nil {
+			return err
 		}
+		curve.style = style
 	}
 
-	switch data.(type) {
-	case CandlesticksData:
-		curve.castedData = data.(CandlesticksData)
-		if plot.dimensions == 2 {
-			plot.plotCandlesticks(curve)
-		} else {
-			return &gnuplotError{fmt.Sprintf("Unsupported data with this dimensions")}
-		}
-		plot.PointGroup[name] = curve
-	case [][]float64:
-		if plot.dimensions != len(data.([][]float64)) {
-			return &gnuplotError{fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot.")}
-		}
-		curve.castedData = data.([][]float64)
-		if plot.dimensions == 2 {
-			plot.plotXY(curve)
-		} else {
-			plot.plotXYZ(curve)
-		}
-		plot.PointGroup[name] = curve
-
-	case [][]float32:
-		if plot.dimensions != len(data.([][]float32)) {
-			return &gnuplotError{fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot.")}
-		}
-		originalSlice := data.([][]float32)
-		typeCasteSlice := make([][]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = make([]float64, len(originalSlice[i]))
-			for j := 0; j < len(originalSlice[i]); j++ {
-				typeCasteSlice[i][j] = float64(originalSlice[i][j])
-			}
-		}
-		curve.castedData = typeCasteSlice
-		if plot.dimensions == 2 {
-			plot.plotXY(curve)
-		} else {
-			plot.plotXYZ(curve)
-		}
-		plot.PointGroup[name] = curve
-
-	case [][]int:
-		if plot.dimensions != len(data.([][]int)) {
-			return &gnuplotError{fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot.")}
-		}
-		originalSlice := data.([][]int)
-		if len(originalSlice) != 2 {
-			return &gnuplotError{fmt.Sprintf("this is not a 2d matrix")}
-		}
-		typeCasteSlice := make([][]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = make([]float64, len(originalSlice[i]))
-			for j := 0; j < len(originalSlice[i]); j++ {
-				typeCasteSlice[i][j] = float64(originalSlice[i][j])
-			}
-		}
-		curve.castedData = typeCasteSlice
-		if plot.dimensions == 2 {
-			plot.plotXY(curve)
-		} else {
-			plot.plotXYZ(curve)
-		}
-		plot.PointGroup[name] = curve
-
-	case [][]int8:
-		if plot.dimensions != len(data.([][]int8)) {
-			return &gnuplotError{fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot.")}
-		}
-		originalSlice := data.([][]int8)
-		if len(originalSlice) != 2 {
-			return &gnuplotError{fmt.Sprintf("this is not a 2d matrix")}
-		}
-		typeCasteSlice := make([][]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = make([]float64, len(originalSlice[i]))
-			for j := 0; j < len(originalSlice[i]); j++ {
-				typeCasteSlice[i][j] = float64(originalSlice[i][j])
-			}
-		}
-		curve.castedData = typeCasteSlice
-
-		if plot.dimensions == 2 {
-			plot.plotXY(curve)
-		} else {
-			plot.plotXYZ(curve)
-		}
-		plot.PointGroup[name] = curve
+	err = plot.setData(curve, data)
+	if err != nil {
+		return err
+	}
+	err = plot.plotPointGroup(curve)
+	if err != nil {
+		return err
+	}
+	plot.PointGroup[name] = curve
+	return nil
+}
 
-	case [][]int16:
-		if plot.dimensions != len(data.([][]int16)) {
-			return &gnuplotError{fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot.")}
-		}
-		originalSlice := data.([][]int16)
-		if len(originalSlice) != 2 {
-			return &gnuplotError{fmt.Sprintf("this is not a 2d matrix")}
-		}
-		typeCasteSlice := make([][]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = make([]float64, len(originalSlice[i]))
-			for j := 0; j < len(originalSlice[i]); j++ {
-				typeCasteSlice[i][j] = float64(originalSlice[i][j])
+// setData checks that the data can be drawn on the plot and keeps it on the PointGroup,
+// typecasted to float64 or as one of the data types of this package. The caller holds mu.
+func (plot *Plot) setData(pointGroup *PointGroup, data interface{}) error {
+	unsupported := &gnuplotError{err: fmt.Sprintf("Unsupported data with this dimensions"), kind: ErrInvalidDimensions}
+	pointGroup.owned = false
+	switch data := data.(type) {
+	case CandlesticksData, TimeSeriesData, BoxPlotData, ErrorBarData, HistogramData, ScatterData, AreaData, ViolinData:
+		if plot.dimensions != 2 {
+			return unsupported
+		}
+		pointGroup.castedData = data
+	case SurfaceData:
+		if plot.dimensions != 3 {
+			return unsupported
+		}
+		pointGroup.castedData = data
+	case FunctionData, VectorData, HeatmapData:
+		pointGroup.castedData = data
+	default:
+		castedData, err := castData(data)
+		if err != nil {
+			return err
+		}
+		// castData copies the values, so they are not the slices of the caller.
+		pointGroup.owned = true
+		switch castedData := castedData.(type) {
+		case []float64:
+			pointGroup.castedData = castedData
+		case [][]float64:
+			if pointGroup.style == "heatmap" {
+				pointGroup.castedData = HeatmapData{Matrix: castedData}
+				break
 			}
-		}
-		curve.castedData = typeCasteSlice
-
-		if plot.dimensions == 2 {
-			plot.plotXY(curve)
-		} else {
-			plot.plotXYZ(curve)
-		}
-		plot.PointGroup[name] = curve
-
-	case [][]int32:
-		if plot.dimensions != len(data.([][]int32)) {
-			return &gnuplotError{fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot.")}
-		}
-		originalSlice := data.([][]int32)
-		if len(originalSlice) != 2 {
-			return &gnuplotError{fmt.Sprintf("this is not a 2d matrix")}
-		}
-		typeCasteSlice := make([][]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = make([]float64, len(originalSlice[i]))
-			for j := 0; j < len(originalSlice[i]); j++ {
-				typeCasteSlice[i][j] = float64(originalSlice[i][j])
+			if plot.dimensions != len(castedData) {
+				return &gnuplotError{err: fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot."), kind: ErrInvalidDimensions}
 			}
-		}
-		curve.castedData = typeCasteSlice
-
-		if plot.dimensions == 2 {
-			plot.plotXY(curve)
-		} else {
-			plot.plotXYZ(curve)
-		}
-		plot.PointGroup[name] = curve
-
-	case [][]int64:
-		if plot.dimensions != len(data.([][]int64)) {
-			return &gnuplotError{fmt.Sprintf("The dimensions of this PointGroup are not compatible with the dimensions of the plot.\nIf you want to make a 2-d curve you must specify a 2-d plot.")}
-		}
-		originalSlice := data.([][]int64)
-		if len(originalSlice) != 2 {
-			return &gnuplotError{fmt.Sprintf("this is not a 2d matrix")}
-		}
-		typeCasteSlice := make([][]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = make([]float64, len(originalSlice[i]))
-			for j := 0; j < len(originalSlice[i]); j++ {
-				typeCasteSlice[i][j] = float64(originalSlice[i][j])
+			if plot.dimensions == 1 {
+				pointGroup.castedData = castedData[0]
+			} else {
+				pointGroup.castedData = castedData
 			}
 		}
-		curve.castedData = typeCasteSlice
-
-		if plot.dimensions == 2 {
-			plot.plotXY(curve)
-		} else {
-			plot.plotXYZ(curve)
-		}
-		plot.PointGroup[name] = curve
-
-	case []float64:
-		curve.castedData = data.([]float64)
-		plot.plotX(curve)
-		plot.PointGroup[name] = curve
-	case []float32:
-		originalSlice := data.([]float32)
-		typeCasteSlice := make([]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = float64(originalSlice[i])
-		}
-		curve.castedData = typeCasteSlice
-		plot.plotX(curve)
-		plot.PointGroup[name] = curve
-	case []int:
-		originalSlice := data.([]int)
-		typeCasteSlice := make([]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = float64(originalSlice[i])
-		}
-		curve.castedData = typeCasteSlice
-		plot.plotX(curve)
-		plot.PointGroup[name] = curve
-	case []int8:
-		originalSlice := data.([]int8)
-		typeCasteSlice := make([]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = float64(originalSlice[i])
-		}
-		curve.castedData = typeCasteSlice
-		plot.plotX(curve)
-		plot.PointGroup[name] = curve
-	case []int16:
-		originalSlice := data.([]int16)
-		typeCasteSlice := make([]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = float64(originalSlice[i])
-		}
-		curve.castedData = typeCasteSlice
-		plot.plotX(curve)
-		plot.PointGroup[name] = curve
-	case []int32:
-		originalSlice := data.([]int32)
-		typeCasteSlice := make([]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = float64(originalSlice[i])
-		}
-		curve.castedData = typeCasteSlice
-		plot.plotX(curve)
-		plot.PointGroup[name] = curve
-	case []int64:
-		originalSlice := data.([]int64)
-		typeCasteSlice := make([]float64, len(originalSlice))
-		for i := 0; i < len(originalSlice); i++ {
-			typeCasteSlice[i] = float64(originalSlice[i])
-		}
-		curve.castedData = typeCasteSlice
-		plot.plotX(curve)
-		plot.PointGroup[name] = curve
-	default:
-		return &gnuplotError{fmt.Sprintf("invalid number of dims ")}
-
-	}
-	if discovered == 0 {
-		fmt.Printf("** style '%v' not in allowed list %v\n", style, allowed)
-		fmt.Printf("** default to 'points'\n")
-		err = &gnuplotError{fmt.Sprintf("invalid style '%s'", style)}
 	}
-	return err
+	pointGroup.data = data
+	return nil
 }
 
 // RemovePointGroup helps to remove a particular point group from the plot.
@@ -347,11 +266,66 @@ func (plot *Plot) AddPointGroupAdvance(
 //  plot.AddPointGroup("Sample2", "points", []int32{1, 2, 4, 11})
 //  plot.RemovePointGroup("Sample1")
 func (plot *Plot) RemovePointGroup(name string) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.removePointGroup(name)
+}
+
+// removePointGroup removes a PointGroup and draws the others again, without writing their data files again.
+func (plot *Plot) removePointGroup(name string) {
+	pointGroup, exists := plot.PointGroup[name]
+	if !exists {
+		return
+	}
 	delete(plot.PointGroup, name)
-	plot.cleanplot()
-	for _, pointGroup := range plot.PointGroup {
-		plot.plotX(pointGroup)
+	if len(pointGroup.specs) == 0 {
+		return
+	}
+	plot.nplots--
+	if plot.nplots > 0 {
+		plot.Cmd("%s", plot.plotAllCmd())
+	}
+}
+
+// plotPointGroup writes the data file of a PointGroup and plots it with its current style.
+func (plot *Plot) plotPointGroup(pointGroup *PointGroup) error {
+	switch data := pointGroup.castedData.(type) {
+	case CandlesticksData:
+		if pointGroup.style == "financebars" {
+			return plot.plotFinanceBars(pointGroup)
+		}
+		return plot.plotCandlesticks(pointGroup)
+	case TimeSeriesData:
+		return plot.plotTimeSeries(pointGroup)
+	case SurfaceData:
+		return plot.plotSurface(pointGroup)
+	case BoxPlotData:
+		return plot.plotBoxPlot(pointGroup)
+	case ViolinData:
+		return plot.plotViolin(pointGroup)
+	case ErrorBarData:
+		return plot.plotErrorBars(pointGroup)
+	case HistogramData:
+		return plot.plotHistogram(pointGroup)
+	case HeatmapData:
+		return plot.plotHeatmap(pointGroup)
+	case VectorData:
+		return plot.plotVectors(pointGroup)
+	case FunctionData:
+		return plot.plotFunction(pointGroup)
+	case AreaData:
+		return plot.plotArea(pointGroup)
+	case ScatterData:
+		return plot.plotScatter(pointGroup)
+	case []float64:
+		return plot.plotX(pointGroup)
+	case [][]float64:
+		if len(data) == 3 {
+			return plot.plotXYZ(pointGroup)
+		}
+		return plot.plotXY(pointGroup)
 	}
+	return &gnuplotError{err: fmt.Sprintf("The PointGroup %s can't be plotted again.", pointGroup.name)}
 }
 
 // ResetPointGroupStyle helps to reset the style of a particular point group in a plot.
@@ -366,12 +340,265 @@ func (plot *Plot) RemovePointGroup(name string) {
 //  plot.AddPointGroup("Sample1", "points", []int32{51, 8, 4, 11})
 //  plot.ResetPointGroupStyle("Sample1", "points")
 func (plot *Plot) ResetPointGroupStyle(name string, style string) (err error) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
 	pointGroup, exists := plot.PointGroup[name]
 	if !exists {
-		return &gnuplotError{fmt.Sprintf("A curve with name %s does not exist.", name)}
+		return &gnuplotError{err: fmt.Sprintf("A curve with name %s does not exist.", name)}
+	}
+	return plot.restyle(pointGroup, style)
+}
+
+// restyle draws a PointGroup again with another style. The caller holds mu.
+func (plot *Plot) restyle(pointGroup *PointGroup, style string) error {
+	err := plot.checkStyle(style)
+	if err != nil {
+		return err
+	}
+	if _, isFile := pointGroup.castedData.(string); isFile {
+		// The data file of AddDataFile is plotted as it is, so only the style of the plot command changes.
+		pointGroup.specs[0] = strings.TrimSuffix(pointGroup.specs[0], " with "+pointGroup.style) + " with " + style
+		pointGroup.style = style
+		return plot.Cmd("%s", plot.plotAllCmd())
 	}
-	plot.RemovePointGroup(name)
 	pointGroup.style = style
-	plot.plotX(pointGroup)
-	return err
+	return plot.plotPointGroup(pointGroup)
+}
+
+// SetStyle draws the curve again with another style.
+//
+// Usage
+//  curve, _ := plot.Add("Sample1", "lines", []int32{51, 8, 4, 11})
+//  curve.SetStyle("points")
+func (pointGroup *PointGroup) SetStyle(style string) error {
+	pointGroup.plot.mu.Lock()
+	defer pointGroup.plot.mu.Unlock()
+	return pointGroup.plot.restyle(pointGroup, style)
+}
+
+// SetName renames the curve and draws it again with the new name in the legend.
+//
+// Usage
+//  curve, _ := plot.Add("Sample1", "lines", []int32{51, 8, 4, 11})
+//  curve.SetName("Requests per second")
+func (pointGroup *PointGroup) SetName(name string) error {
+	plot := pointGroup.plot
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	if name == pointGroup.name {
+		return nil
+	}
+	if _, exists := plot.PointGroup[name]; exists {
+		return &gnuplotError{err: fmt.Sprintf("A PointGroup with the name %s  already exists, please use another name of the curve.", name), kind: ErrDuplicatePointGroup}
+	}
+	delete(plot.PointGroup, pointGroup.name)
+	old := pointGroup.name
+	pointGroup.name = name
+	plot.PointGroup[name] = pointGroup
+	if _, isFile := pointGroup.castedData.(string); isFile {
+		pointGroup.replaceInSpecs(" title "+plot.text(old), " title "+plot.text(name), 1)
+		return plot.Cmd("%s", plot.plotAllCmd())
+	}
+	return plot.plotPointGroup(pointGroup)
+}
+
+// UpdateData replaces the data of the curve and draws it again, keeping its
+// name, style and appearance. The data can be of any type AddPointGroup accepts.
+//
+// Usage
+//  curve, _ := plot.Add("Sample1", "lines", []int32{51, 8, 4, 11})
+//  curve.UpdateData([]int32{52, 9, 3, 12})
+func (pointGroup *PointGroup) UpdateData(data interface{}) error {
+	pointGroup.plot.mu.Lock()
+	defer pointGroup.plot.mu.Unlock()
+	return pointGroup.plot.updateData(pointGroup, data)
+}
+
+// UpdatePointGroup replaces the data of a PointGroup and draws the plot again with a
+// single plot command. The temporary data file of the PointGroup is rewritten in place,
+// so its style and appearance are kept and no data files pile up like they do when
+// removing and adding the PointGroup again.
+//
+// Usage
+//  plot.AddPointGroup("Live", "lines", []float64{1, 2, 3})
+//  plot.UpdatePointGroup("Live", []float64{2, 3, 5})
+func (plot *Plot) UpdatePointGroup(name string, data interface{}) error {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	pointGroup, exists := plot.PointGroup[name]
+	if !exists {
+		return &gnuplotError{err: fmt.Sprintf("A curve with name %s does not exist.", name)}
+	}
+	return plot.updateData(pointGroup, data)
+}
+
+// updateData replaces the data of a PointGroup and draws it again. The caller holds mu.
+func (plot *Plot) updateData(pointGroup *PointGroup, data interface{}) error {
+	if _, isFile := pointGroup.castedData.(string); isFile {
+		return &gnuplotError{err: fmt.Sprintf("The data of %s is read from a data file.", pointGroup.name)}
+	}
+	err := plot.setData(pointGroup, data)
+	if err != nil {
+		return err
+	}
+	if pointGroup.source != nil {
+		// The curve gets a data file of its own.
+		pointGroup.source = nil
+		pointGroup.fname = ""
+	}
+	for _, view := range plot.PointGroup {
+		if view.source == pointGroup {
+			view.data, view.castedData = pointGroup.data, pointGroup.castedData
+		}
+	}
+	return plot.plotPointGroup(pointGroup)
+}
+
+// SetColor changes the color of the curve and redraws the plot.
+// The color is either a name like "red" or a hex value like "#ff0000".
+//
+// Usage
+//  plot.AddPointGroup("Sample1", "lines", []int32{51, 8, 4, 11})
+//  plot.PointGroup["Sample1"].SetColor("#1f77b4")
+func (pointGroup *PointGroup) SetColor(color string) error {
+	pointGroup.plot.mu.Lock()
+	pointGroup.color = color
+	pointGroup.plot.mu.Unlock()
+	return pointGroup.plot.redraw()
+}
+
+// SetAlpha changes the opacity of the curve and redraws the plot, so that dense
+// overlapping points stay readable. The alpha goes from 0 (invisible) to 1 (opaque).
+// It is applied to hex colors like "#1f77b4" and to the colors of the color cycle,
+// named colors stay opaque.
+//
+// Usage
+//  plot.AddPointGroup("Sample1", "points", []int32{51, 8, 4, 11})
+//  plot.PointGroup["Sample1"].SetAlpha(0.3)
+func (pointGroup *PointGroup) SetAlpha(alpha float64) error {
+	if alpha < 0 || alpha > 1 {
+		return &gnuplotError{err: fmt.Sprintf("The alpha %v is not between 0 and 1.", alpha)}
+	}
+	if alpha > 0 && alpha < 1 {
+		if err := pointGroup.plot.requireFeature("rgbalpha"); err != nil {
+			return err
+		}
+	}
+	pointGroup.plot.mu.Lock()
+	pointGroup.alpha = alpha
+	pointGroup.plot.mu.Unlock()
+	return pointGroup.plot.redraw()
+}
+
+// SetLineWidth changes the width of the line of the curve and redraws the plot.
+func (pointGroup *PointGroup) SetLineWidth(width float64) error {
+	pointGroup.plot.mu.Lock()
+	pointGroup.lineWidth = width
+	pointGroup.plot.mu.Unlock()
+	return pointGroup.plot.redraw()
+}
+
+// SetDashType changes the dash type of the line of the curve and redraws the plot.
+// 1 is a solid line, the other dash types depend on the terminal.
+func (pointGroup *PointGroup) SetDashType(dashType int) error {
+	if err := pointGroup.plot.requireFeature("dashtype"); err != nil {
+		return err
+	}
+	pointGroup.plot.mu.Lock()
+	pointGroup.dashType = dashType
+	pointGroup.plot.mu.Unlock()
+	return pointGroup.plot.redraw()
+}
+
+// SetFillStyle changes how boxes and areas of the curve are filled and redraws the plot.
+//
+// Usage
+//  plot.AddPointGroup("Sample1", "boxes", []int32{51, 8, 4, 11})
+//  plot.PointGroup["Sample1"].SetFillStyle("solid 0.5")
+//  plot.PointGroup["Sample1"].SetFillStyle("pattern 2")
+func (pointGroup *PointGroup) SetFillStyle(fillStyle string) error {
+	pointGroup.plot.mu.Lock()
+	pointGroup.fillStyle = fillStyle
+	pointGroup.plot.mu.Unlock()
+	return pointGroup.plot.redraw()
+}
+
+// appearance returns the options of the plot command that set the color,
+// line and fill of the curve.
+func (pointGroup *PointGroup) appearance() string {
+	return pointGroup.appearanceOf("")
+}
+
+// appearanceOf returns the options of appearance for a spec of the curve,
+// without the options the spec sets itself, like the fixed color of the volume of candlesticks.
+func (pointGroup *PointGroup) appearanceOf(spec string) string {
+	var options string
+	if color := pointGroup.lineColor(); color != "" && !strings.Contains(spec, " lc ") && !strings.Contains(spec, " palette") {
+		options += " lc rgb " + quote(color)
+	}
+	if pointGroup.lineWidth > 0 && !strings.Contains(spec, " lw ") {
+		options += fmt.Sprintf(" lw %v", pointGroup.lineWidth)
+	}
+	if pointGroup.dashType > 0 && !strings.Contains(spec, " dt ") {
+		options += fmt.Sprintf(" dt %d", pointGroup.dashType)
+	}
+	if pointGroup.fillStyle != "" && !strings.Contains(spec, " fs ") {
+		options += " fs " + pointGroup.fillStyle
+	}
+	return options + pointGroup.smoothOption()
+}
+
+// plotSpec returns the specs of the curve for a plot command, each with the appearance of the curve.
+func (pointGroup *PointGroup) plotSpec() string {
+	specs := make([]string, len(pointGroup.specs))
+	for i, spec := range pointGroup.specs {
+		specs[i] = spec + pointGroup.appearanceOf(spec)
+	}
+	return strings.Join(specs, ", ")
+}
+
+// replaceInSpecs replaces old by new in the specs of the curve, like the name of its data file.
+func (pointGroup *PointGroup) replaceInSpecs(old, new string, n int) {
+	for i, spec := range pointGroup.specs {
+		pointGroup.specs[i] = strings.Replace(spec, old, new, n)
+	}
+}
+
+// defaultColors are the colors gnuplot gives to the curves in turn.
+var defaultColors = []string{"#9400d3", "#009e73", "#56b4e9", "#e69f00", "#f0e442", "#0072b2", "#e51e10", "#000000"}
+
+// lineColor returns the color of the curve, taken from the color cycle of the plot
+// when it has none, with the alpha channel of gnuplot's "#AARRGGBB" notation,
+// where 00 is opaque and ff is transparent.
+func (pointGroup *PointGroup) lineColor() string {
+	color := pointGroup.color
+	if colors := pointGroup.plot.colors; color == "" && len(colors) > 0 && !pointGroup.colorsFromData() {
+		color = colors[pointGroup.index%len(colors)]
+	}
+	if pointGroup.alpha <= 0 || pointGroup.alpha >= 1 {
+		return color
+	}
+	if color == "" {
+		color = defaultColors[pointGroup.index%len(defaultColors)]
+	}
+	if len(color) != 7 || color[0] != '#' {
+		return color
+	}
+	transparency := int((1-pointGroup.alpha)*255 + 0.5)
+	return fmt.Sprintf("#%02x%s", transparency, color[1:])
+}
+
+// colorsFromData reports whether the colors of the curve come from its data through
+// the palette, so that a color of the color cycle would override them.
+func (pointGroup *PointGroup) colorsFromData() bool {
+	switch pointGroup.castedData.(type) {
+	case HeatmapData, SurfaceData, CandlesticksData:
+		return true
+	}
+	for _, spec := range pointGroup.specs {
+		if strings.Contains(spec, " palette") {
+			return true
+		}
+	}
+	return false
 }
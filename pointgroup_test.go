@@ -1,6 +1,10 @@
 package glot
 
-import "testing"
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
 
 func TestResetPointGroupStyle(t *testing.T) {
 	dimensions := 2
@@ -13,3 +17,148 @@ func TestResetPointGroupStyle(t *testing.T) {
 		t.Error("The specified pointgroup to be reset does not exist")
 	}
 }
+
+func TestAddPointGroupHeatmap(t *testing.T) {
+	dimensions := 2
+	persist := false
+	debug := false
+	plot, _ := NewPlot(dimensions, persist, debug)
+	err := plot.AddPointGroup("Heat", "heatmap", [][]float64{{1, 2, 3}, {4, 5, 6}})
+	if err != nil {
+		t.Error("Expected a [][]float64 matrix to be accepted as a heatmap, got ", err)
+	}
+}
+
+func TestAddPointGroupCandlesticksVolume(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	data := CandlesticksData{
+		Timestamps: []int64{1500000000, 1500086400},
+		Candles:    [][]float64{{1, 3, 0.5, 2}, {2, 2.5, 1, 1.5}},
+		Volume:     []float64{100, 150},
+		WickColor:  "black",
+		BoxWidth:   43200,
+	}
+	err := plot.AddPointGroup("Candles", "candlesticks", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := strings.Join(plot.PointGroup["Candles"].specs, ", ")
+	if !strings.Contains(spec, "axes x1y2") || !strings.Contains(spec, "vectors nohead") {
+		t.Error("Expected the volume and the wicks to be plotted, got ", spec)
+	}
+}
+
+func TestResetPointGroupStyleFinanceBars(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	data := CandlesticksData{XArray: []int64{1, 2}, Candles: [][]float64{{1, 3, 0.5, 2}, {2, 2.5, 1, 1.5}}}
+	plot.AddPointGroup("Prices", "candlesticks", data)
+	plot.AddPointGroup("Sample", "lines", [][]float64{{1, 2}, {2, 3}})
+	err := plot.ResetPointGroupStyle("Prices", "financebars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prices := plot.PointGroup["Prices"]
+	if !strings.Contains(strings.Join(prices.specs, ", "), "with financebars") || prices.index != 0 || plot.nplots != 2 {
+		t.Error("Expected the candles to be drawn in place as financebars, got ", strings.Join(prices.specs, ", "))
+	}
+}
+
+func TestAddPointGroupErrorBars(t *testing.T) {
+	dimensions := 2
+	persist := false
+	debug := false
+	plot, _ := NewPlot(dimensions, persist, debug)
+	data := ErrorBarData{X: []float64{1, 2, 3}, Y: []float64{2, 4, 6}, YErr: []float64{0.5}}
+	err := plot.AddPointGroup("Errors", "yerrorbars", data)
+	if err == nil {
+		t.Error("Expected an error when the error array and x-axis array lengths differ.")
+	}
+}
+
+func TestPointGroupSetColor(t *testing.T) {
+	dimensions := 2
+	persist := false
+	debug := false
+	plot, _ := NewPlot(dimensions, persist, debug)
+	plot.AddPointGroup("Sample1", "lines", []int32{51, 8, 4, 11})
+	plot.PointGroup["Sample1"].SetColor("#ff0000")
+	plot.PointGroup["Sample1"].SetLineWidth(2)
+	if s := plot.PointGroup["Sample1"].appearance(); s != " lc rgb \"#ff0000\" lw 2" {
+		t.Error("Unexpected line options ", s)
+	}
+}
+
+func TestPointGroupAppearanceOfSpecs(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	plot.AddPointGroup("Latency", "boxplot", BoxPlotData{Groups: [][]float64{{1, 2}, {3, 4}, {5, 6}}})
+	curve := plot.PointGroup["Latency"]
+	curve.SetColor("#4e79a7")
+	curve.SetLineWidth(3)
+	if spec := curve.plotSpec(); strings.Count(spec, "with boxplot lc rgb \"#4e79a7\" lw 3") != 3 {
+		t.Error("Expected the appearance on every group, got ", spec)
+	}
+	curve.SetFillStyle("solid 0.5")
+	if s := curve.appearanceOf(`x with boxes lc rgb "gray" fs empty`); s != " lw 3" {
+		t.Error("Expected the options the spec doesn't set, got ", s)
+	}
+}
+
+func TestPointGroupSetAlpha(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	plot.AddPointGroup("Sample1", "points", []float64{1, 2, 3})
+	plot.AddPointGroup("Sample2", "points", []float64{3, 2, 1})
+	plot.PointGroup["Sample1"].SetColor("#1f77b4")
+	plot.PointGroup["Sample1"].SetAlpha(0.5)
+	plot.PointGroup["Sample2"].SetAlpha(0.5)
+	if color := plot.PointGroup["Sample1"].lineColor(); color != "#801f77b4" {
+		t.Error("Expected #801f77b4, got ", color)
+	}
+	if color := plot.PointGroup["Sample2"].lineColor(); color != "#80f28e2b" {
+		t.Error("Expected the second color of the color cycle, got ", color)
+	}
+	if plot.PointGroup["Sample1"].SetAlpha(2) == nil {
+		t.Error("Expected an error for an alpha above 1")
+	}
+}
+
+func TestPointGroupHandle(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	curve, err := plot.Add("Sample1", "lines", []float64{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	curve.SetColor("red")
+	if err := curve.SetName("Renamed"); err != nil {
+		t.Fatal(err)
+	}
+	if plot.PointGroup["Renamed"] != curve || plot.PointGroup["Sample1"] != nil {
+		t.Error("Expected the PointGroup to be kept under its new name")
+	}
+	curve.SetStyle("points")
+	if err := curve.UpdateData([]int32{4, 5}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(strings.Join(curve.specs, ", "), "title \"Renamed\" with points") || len(curve.castedData.([]float64)) != 2 {
+		t.Error("Unexpected PointGroup ", strings.Join(curve.specs, ", "), curve.castedData)
+	}
+	if curve.UpdateData([][]float64{{1}, {2}, {3}}) == nil {
+		t.Error("Expected an error for 3-d data on a 2-d plot")
+	}
+}
+
+func TestUpdatePointGroupReusesDataFile(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.AddPointGroup("Live", "lines", [][]float64{{1, 2, 3}, {4, 5, 6}})
+	fname := plot.PointGroup["Live"].fname
+	if err := plot.UpdatePointGroup("Live", [][]float64{{1, 2}, {7, 8}}); err != nil {
+		t.Fatal(err)
+	}
+	if plot.PointGroup["Live"].fname != fname || len(plot.tmpfiles) != 1 {
+		t.Error("Expected the data file to be reused, got ", plot.tmpfiles)
+	}
+	content, _ := ioutil.ReadFile(fname)
+	if string(content) != "1 7\n2 8\n" {
+		t.Errorf("Unexpected data file content %q", content)
+	}
+}
@@ -0,0 +1,64 @@
+package glot
+
+import "fmt"
+
+// AngleUnit is the unit of the angles of polar plots.
+type AngleUnit string
+
+// The angle units of polar plots.
+const (
+	Radians AngleUnit = "radians"
+	Degrees AngleUnit = "degrees"
+)
+
+// SetPolar turns the polar mode of the plot on or off. In polar mode the
+// x column of the data is the angle and the y column the radius.
+//
+// Usage
+//  plot.SetPolar(true)
+//  plot.SetAngles(glot.Degrees)
+//  plot.AddPointGroup("Wind", "lines", [][]float64{{0, 90, 180, 270, 360}, {3, 5, 2, 4, 3}})
+func (plot *Plot) SetPolar(on bool) error {
+	if !on {
+		return plot.set("polar", "unset polar")
+	}
+	return plot.set("polar", "set polar")
+}
+
+// SetAngles sets the unit of the angles of polar plots, radians by default.
+func (plot *Plot) SetAngles(unit AngleUnit) error {
+	if unit != Radians && unit != Degrees {
+		return &gnuplotError{err: fmt.Sprintf("invalid angle unit '%s'", unit)}
+	}
+	return plot.set("angles", "set angles %s", unit)
+}
+
+// SetRTics puts a tic mark on the radial axis of a polar plot every interval,
+// with a circle of the polar grid at every tic.
+func (plot *Plot) SetRTics(interval float64) error {
+	return plot.set("rtics", "set rtics %v\nset grid polar", interval)
+}
+
+// AddPolarPointGroup turns the polar mode on and adds a PointGroup
+// with the radius r[i] at the angle theta[i].
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.SetAngles(glot.Degrees)
+//  plot.AddPolarPointGroup("Antenna", "lines", []float64{0, 90, 180, 270, 360}, []float64{1, 0.5, 0.2, 0.5, 1})
+func (plot *Plot) AddPolarPointGroup(name string, style string, theta, r []float64) error {
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("Polar plots must be 2-d plots."), kind: ErrInvalidDimensions}
+	}
+	if len(theta) != len(r) {
+		return &gnuplotError{err: fmt.Sprintf("The length of the angle array and radius array are not same.")}
+	}
+	err := plot.SetPolar(true)
+	if err != nil {
+		return err
+	}
+	return plot.AddPointGroup(name, style, [][]float64{theta, r})
+}
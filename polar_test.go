@@ -0,0 +1,17 @@
+package glot
+
+import "testing"
+
+func TestAddPolarPointGroup(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddPolarPointGroup("Antenna", "lines", []float64{0, 90, 180}, []float64{1, 0.5, 0.2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plot.options[0].cmd != "set polar" {
+		t.Error("Expected the polar mode to be kept as a setting")
+	}
+	if plot.SetAngles("gradians") == nil {
+		t.Error("Expected an error for an invalid angle unit.")
+	}
+}
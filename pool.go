@@ -0,0 +1,111 @@
+package glot
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// PlotterPool keeps gnuplot processes running between plots, so that making
+// a plot doesn't have to start a new gnuplot process every time.
+// This pays off when many small plots are saved in a batch job.
+// Plots made by a pool hand their process back to the pool on Close.
+type PlotterPool struct {
+	mu     sync.Mutex
+	idle   []*plotterProcess
+	size   int
+	closed bool
+}
+
+// NewPlotterPool makes a pool that keeps at most size idle gnuplot processes.
+//
+// Usage
+//  pool := glot.NewPlotterPool(4)
+//  defer pool.Close()
+//  for i, data := range series {
+//  	plot, _ := pool.NewPlot(2, false)
+//  	plot.AddPointGroup("Series", "lines", data)
+//  	plot.SavePlot(fmt.Sprintf("%d.png", i))
+//  	plot.Close()
+//  }
+func NewPlotterPool(size int) *PlotterPool {
+	return &PlotterPool{size: size}
+}
+
+// NewPlot makes a plot that uses an idle gnuplot process of the pool,
+// or a new one when none is idle. Plots of a pool don't persist.
+func (pool *PlotterPool) NewPlot(dimensions int, debug bool) (*Plot, error) {
+	plot, err := newPlot(context.Background(), dimensions, debug)
+	if err != nil {
+		return nil, err
+	}
+	pool.mu.Lock()
+	if n := len(pool.idle); n > 0 {
+		plot.proc = pool.idle[n-1]
+		pool.idle = pool.idle[:n-1]
+	}
+	pool.mu.Unlock()
+	if plot.proc == nil {
+		plot.proc, err = newPlotterProc(context.Background(), gGnuplotCmd, false)
+		if err != nil {
+			return nil, err
+		}
+		// Remember the default terminal so that release can restore it.
+		_, err = io.WriteString(plot.proc.stdin, "set terminal push\n")
+		if err != nil {
+			plot.proc.kill()
+			return nil, err
+		}
+	}
+	plot.pool = pool
+	plot.setFinalizer()
+	return plot, nil
+}
+
+// release resets the state of the process and keeps it for the next plot,
+// or stops it when the pool is full or closed.
+func (pool *PlotterPool) release(proc *plotterProcess) error {
+	if proc == nil {
+		return nil
+	}
+	if !pool.hasRoom() {
+		return proc.wait()
+	}
+	_, err := proc.write("set output\nset terminal pop\nset terminal push\nreset\n")
+	if err != nil {
+		proc.kill()
+		proc.wait()
+		return err
+	}
+	// The errors of the last plot must not show up in the next one.
+	proc.errlog.reset()
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.closed || len(pool.idle) >= pool.size {
+		return proc.wait()
+	}
+	pool.idle = append(pool.idle, proc)
+	return nil
+}
+
+// hasRoom reports whether the pool keeps another idle process.
+func (pool *PlotterPool) hasRoom() bool {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return !pool.closed && len(pool.idle) < pool.size
+}
+
+// Close stops all idle gnuplot processes of the pool.
+// Plots that are still in use stop their process when they are closed.
+func (pool *PlotterPool) Close() (err error) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	pool.closed = true
+	for _, proc := range pool.idle {
+		if werr := proc.wait(); werr != nil {
+			err = werr
+		}
+	}
+	pool.idle = nil
+	return err
+}
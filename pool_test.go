@@ -0,0 +1,40 @@
+package glot
+
+import "testing"
+
+func TestPlotterPoolReuse(t *testing.T) {
+	pool := NewPlotterPool(1)
+	defer pool.Close()
+	first, _ := pool.NewPlot(2, false)
+	proc := first.proc
+	first.Close()
+	second, _ := pool.NewPlot(2, false)
+	if second.proc != proc {
+		t.Error("Expected the gnuplot process of a closed plot to be reused.")
+	}
+}
+
+func TestPlotterPoolResetsErrors(t *testing.T) {
+	pool := NewPlotterPool(1)
+	defer pool.Close()
+	first, err := pool.NewPlot(2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := first.proc.errlog
+	log.mu.Lock()
+	log.errs = append(log.errs, &CommandError{Command: "set xrange [0:", Message: "invalid expression"})
+	log.mu.Unlock()
+	first.Close()
+	second, err := pool.NewPlot(2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	if second.proc != first.proc {
+		t.Fatal("Expected the gnuplot process of a closed plot to be reused.")
+	}
+	if errs := second.Errors(); len(errs) != 0 {
+		t.Errorf("Expected no errors of the closed plot, got %v", errs)
+	}
+}
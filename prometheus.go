@@ -0,0 +1,90 @@
+package glot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// prometheusResponse is the JSON response of Prometheus' query_range API.
+type prometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][2]interface{}  `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// AddFromPrometheus adds a line for every series of the response of a Prometheus range query,
+// /api/v1/query_range, with the times of the samples on the x-axis. The lines are named
+// after the metric and labels of the series, like up{job="node"}.
+//
+// Usage
+//  resp, _ := http.Get("http://prometheus:9090/api/v1/query_range?query=rate(http_requests_total[5m])&start=...&end=...&step=60")
+//  defer resp.Body.Close()
+//  plot.AddFromPrometheus(resp.Body)
+//  plot.SavePlot("requests.png")
+func (plot *Plot) AddFromPrometheus(r io.Reader) error {
+	var response prometheusResponse
+	err := json.NewDecoder(r).Decode(&response)
+	if err != nil {
+		return err
+	}
+	if response.Status != "success" {
+		return &gnuplotError{err: fmt.Sprintf("The Prometheus query failed: %s: %s", response.ErrorType, response.Error)}
+	}
+	if response.Data.ResultType != "matrix" {
+		return &gnuplotError{err: fmt.Sprintf("The Prometheus result is a %s, not the matrix of a range query.", response.Data.ResultType), kind: ErrUnsupportedDataType}
+	}
+	for i, series := range response.Data.Result {
+		name := prometheusName(series.Metric)
+		if name == "" {
+			name = fmt.Sprintf("series %d", i)
+		}
+		data := TimeSeriesData{Time: make([]time.Time, len(series.Values)), Y: make([]float64, len(series.Values))}
+		for j, sample := range series.Values {
+			timestamp, ok := sample[0].(float64)
+			value, ok2 := sample[1].(string)
+			if !ok || !ok2 {
+				return &gnuplotError{err: fmt.Sprintf("The sample %v of %s is not a [time, \"value\"] pair.", sample, name), kind: ErrUnsupportedDataType}
+			}
+			// Prometheus has millisecond timestamps, round away the error of the float.
+			ms := int64(math.Floor(timestamp*1000 + 0.5))
+			data.Time[j] = time.Unix(ms/1000, ms%1000*int64(time.Millisecond)).UTC()
+			data.Y[j], err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				return &gnuplotError{err: fmt.Sprintf("The value %q of %s is not a number.", value, name), kind: ErrUnsupportedDataType}
+			}
+		}
+		err = plot.AddPointGroup(name, "lines", data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prometheusName formats a metric with its labels the way Prometheus shows them.
+func prometheusName(metric map[string]string) string {
+	var labels []string
+	for label, value := range metric {
+		if label != "__name__" {
+			labels = append(labels, fmt.Sprintf("%s=%q", label, value))
+		}
+	}
+	sort.Strings(labels)
+	if len(labels) == 0 {
+		return metric["__name__"]
+	}
+	return metric["__name__"] + "{" + strings.Join(labels, ",") + "}"
+}
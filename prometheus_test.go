@@ -0,0 +1,29 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddFromPrometheus(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	response := `{"status": "success", "data": {"resultType": "matrix", "result": [
+		{"metric": {"__name__": "up", "job": "node", "instance": "a:9100"}, "values": [[1435781430.781, "1"], [1435781445.781, "0"]]}]}}`
+	err := plot.AddFromPrometheus(strings.NewReader(response))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pointGroup, exists := plot.PointGroup[`up{instance="a:9100",job="node"}`]
+	if !exists {
+		t.Fatal("Expected a PointGroup named after the series, got ", plot.PointGroup)
+	}
+	data := pointGroup.castedData.(TimeSeriesData)
+	if data.Time[0].Unix() != 1435781430 || data.Time[0].Nanosecond()/1e6 != 781 || data.Y[1] != 0 {
+		t.Error("Unexpected data ", data)
+	}
+	err = plot.AddFromPrometheus(strings.NewReader(`{"status": "error", "errorType": "bad_data", "error": "parse error"}`))
+	if err == nil || !strings.Contains(err.Error(), "parse error") {
+		t.Error("Expected the error of the query, got ", err)
+	}
+}
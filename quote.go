@@ -0,0 +1,44 @@
+package glot
+
+import "strings"
+
+// quoteEscaper escapes the characters gnuplot interprets in double quoted strings.
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// enhancedEscaper escapes the characters of gnuplot's enhanced text mode.
+var enhancedEscaper = strings.NewReplacer(`\`, `\\`, `^`, `\^`, `_`, `\_`, `@`, `\@`, `&`, `\&`, `~`, `\~`, `{`, `\{`, `}`, `\}`)
+
+// quote returns s as a gnuplot string in double quotes, with its quotes,
+// backslashes and line breaks escaped. Unicode text is sent as it is.
+func quote(s string) string {
+	return `"` + quoteEscaper.Replace(s) + `"`
+}
+
+// escapeQuotes escapes a string that is sent to gnuplot in double quotes.
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+// SetLiteralText makes the titles, labels and PointGroup names appear as they are written.
+// gnuplot's enhanced text mode, which most terminals use, reads ^ and _ in them as
+// superscripts and subscripts and { } @ & ~ as markup, so glot escapes these
+// characters when literal text is on, unless the terminal options hold noenhanced.
+// It is off by default, so that texts can hold enhanced text markup like "x^2".
+//
+// Usage
+//  plot.SetLiteralText(true)
+//  plot.SetTitle("file_name_with_underscores.csv")
+func (plot *Plot) SetLiteralText(on bool) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.literal = on
+}
+
+// text returns a title, label or name as a gnuplot string, see SetLiteralText.
+// The caller holds mu.
+func (plot *Plot) text(s string) string {
+	if plot.literal && !strings.Contains(plot.terminal.Extra, "noenhanced") {
+		s = enhancedEscaper.Replace(s)
+	}
+	return quote(s)
+}
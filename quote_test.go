@@ -0,0 +1,54 @@
+package glot
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetTitle("Say \"hi\"\nC:\\data")
+	if cmd := plot.options[0].cmd; cmd != `set title "Say \"hi\"\nC:\\data"` {
+		t.Error("Unexpected command ", cmd)
+	}
+	plot.SetLiteralText(true)
+	plot.AddPointGroup("x_1^2 σ", "lines", []float64{1, 2})
+	if spec := strings.Join(plot.PointGroup["x_1^2 σ"].specs, ", "); !strings.Contains(spec, `title "x\\_1\\^2 σ"`) {
+		t.Error("Expected the enhanced text markup to be escaped, got ", spec)
+	}
+}
+
+func TestDataFilePath(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "glot data\\")
+	defer os.RemoveAll(dir)
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetTempDir(dir)
+	plot.AddPointGroup("Sample1", "lines", []float64{1, 2})
+	pointGroup := plot.PointGroup["Sample1"]
+	if !strings.HasPrefix(strings.Join(pointGroup.specs, ", "), quote(pointGroup.fname)+" ") || !strings.Contains(strings.Join(pointGroup.specs, ", "), `data\\`) {
+		t.Error("Expected the escaped path of the data file, got ", strings.Join(pointGroup.specs, ", "))
+	}
+	plot.Cmd("set grid\r\nset key left")
+	if script := plot.DumpScript(); !strings.HasSuffix(script, "set grid\nset key left\n") {
+		t.Errorf("Expected the CRLF line ends to be replaced, got %q", script)
+	}
+}
+
+func TestColorsAreQuoted(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	const color = `red" lw 100 "`
+	plot.AddPointGroup("Sample 1", "lines", []float64{1, 2})
+	plot.PointGroup["Sample 1"].SetColor(color)
+	plot.SetPaletteStops(PaletteStop{0, color}, PaletteStop{1, "blue"})
+	script := plot.DumpScript()
+	for _, expected := range []string{`lc rgb "red\" lw 100 \""`, `set palette defined (0 "red\" lw 100 \"", 1 "blue")`} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("Expected %q in the script:\n%s", expected, script)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// SetNiceRanges sets the ranges of the axes from the extents of the data of the PointGroups,
+// computed in Go instead of by gnuplot's autoscaling. The extents are widened by padding,
+// a fraction of their size like 0.05 for 5%, and rounded outwards to "nice" numbers,
+// with tics every 1, 2 or 5 times a power of ten. Constant data gets a range around its value.
+// It applies to the data of the PointGroups added so far, so call it again after adding more.
+// Data files and functions don't count for the extents.
+//
+// Usage
+//  plot.AddPointGroup("Sample1", "points", [][]float64{{0.1, 0.2, 0.3}, {1001, 1001, 1001}})
+//  plot.SetNiceRanges(0.05)
+func (plot *Plot) SetNiceRanges(padding float64) error {
+	if padding < 0 {
+		return &gnuplotError{err: fmt.Sprintf("The padding %v is negative.", padding)}
+	}
+	plot.mu.Lock()
+	var extents [3]extent
+	for _, pointGroup := range plot.PointGroup {
+		columns := dataColumns(pointGroup.castedData)
+		for axis := range columns {
+			if axis < len(extents) {
+				extents[axis].add(columns[axis]...)
+			}
+		}
+	}
+	axes := plot.dimensions
+	plot.mu.Unlock()
+	if axes < 2 {
+		// 1-d plots draw the values on the y-axis.
+		axes = 2
+	}
+
+	setters := []struct {
+		setRange func(min, max float64) error
+		setTics  func(interval float64) error
+	}{
+		{plot.SetXRange, plot.SetXTics},
+		{plot.SetYRange, plot.SetYTics},
+		{plot.SetZRange, func(interval float64) error { return plot.set("ztics", "set ztics %v", interval) }},
+	}
+	for axis, e := range extents[:axes] {
+		if e.empty() {
+			continue
+		}
+		min, max, step := niceRange(e.min, e.max, padding)
+		err := setters[axis].setRange(min, max)
+		if err != nil {
+			return err
+		}
+		err = setters[axis].setTics(step)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extent is the smallest and largest value of an axis.
+type extent struct {
+	min, max float64
+	n        int
+}
+
+func (e *extent) add(values ...float64) {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		if e.n == 0 || v < e.min {
+			e.min = v
+		}
+		if e.n == 0 || v > e.max {
+			e.max = v
+		}
+		e.n++
+	}
+}
+
+func (e *extent) empty() bool {
+	return e.n == 0
+}
+
+// dataColumns returns the values of the data of a PointGroup per axis, x first.
+func dataColumns(data interface{}) [][]float64 {
+	switch data := data.(type) {
+	case []float64:
+		return [][]float64{{0, float64(len(data) - 1)}, data}
+	case [][]float64:
+		return data
+	case ScatterData:
+		return [][]float64{data.X, data.Y}
+	case AreaData:
+		return [][]float64{data.X, append(append([]float64{}, data.Y...), data.Y2...)}
+	case ErrorBarData:
+		x := append([]float64{}, data.X...)
+		y := append([]float64{}, data.Y...)
+		for i := range data.X {
+			if i < len(data.XErr) {
+				x = append(x, data.X[i]-data.XErr[i], data.X[i]+data.XErr[i])
+			}
+			if i < len(data.YErr) {
+				y = append(y, data.Y[i]-data.YErr[i], data.Y[i]+data.YErr[i])
+			}
+		}
+		return [][]float64{x, append(append(y, data.YLow...), data.YHigh...)}
+	case VectorData:
+		x := append([]float64{}, data.X...)
+		y := append([]float64{}, data.Y...)
+		for i := range data.X {
+			if i < len(data.DX) && i < len(data.DY) {
+				x = append(x, data.X[i]+data.DX[i])
+				y = append(y, data.Y[i]+data.DY[i])
+			}
+		}
+		return [][]float64{x, y}
+	}
+	return nil
+}
+
+// niceRange pads the range from min to max and rounds it outwards to a multiple
+// of a nice tic interval, aiming at about 5 tics.
+func niceRange(min, max, padding float64) (float64, float64, float64) {
+	if min == max {
+		// Constant data, make room around the value.
+		delta := math.Abs(min) * 0.1
+		if delta == 0 {
+			delta = 1
+		}
+		min, max = min-delta, max+delta
+	}
+	pad := (max - min) * padding
+	min, max = min-pad, max+pad
+	step := niceNumber((max - min) / 5)
+	return roundTo(math.Floor(min/step)*step, step), roundTo(math.Ceil(max/step)*step, step), step
+}
+
+// niceNumber returns the number of the form 1, 2 or 5 times a power of ten closest to x.
+func niceNumber(x float64) float64 {
+	exponent := math.Floor(math.Log10(x))
+	fraction := x / math.Pow(10, exponent)
+	nice := 10.0
+	switch {
+	case fraction < 1.5:
+		nice = 1
+	case fraction < 3:
+		nice = 2
+	case fraction < 7:
+		nice = 5
+	}
+	return nice * math.Pow(10, exponent)
+}
+
+// roundTo rounds away the floating point error of a multiple of step, like 0.30000000000000004.
+func roundTo(x, step float64) float64 {
+	decimals := int(-math.Floor(math.Log10(step)))
+	if decimals < 0 {
+		decimals = 0
+	}
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(x, 'f', decimals, 64), 64)
+	return rounded
+}
@@ -0,0 +1,31 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestNiceRange(t *testing.T) {
+	cases := []struct{ min, max, padding, niceMin, niceMax, step float64 }{
+		{0.13, 0.92, 0, 0, 1, 0.2},
+		{1001, 1001, 0.05, 850, 1150, 50},
+		{0, 0, 0, -1, 1, 0.5},
+		{-3, 47, 0.1, -10, 60, 10},
+	}
+	for _, c := range cases {
+		min, max, step := niceRange(c.min, c.max, c.padding)
+		if min != c.niceMin || max != c.niceMax || step != c.step {
+			t.Errorf("niceRange(%v, %v, %v) = %v, %v, %v", c.min, c.max, c.padding, min, max, step)
+		}
+	}
+}
+
+func TestSetNiceRanges(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.AddPointGroup("Sample1", "points", [][]float64{{0.1, 0.2, 0.3}, {2, 4, 9.5}})
+	plot.SetNiceRanges(0)
+	settings := plot.Settings()
+	if settings.XRange == nil || *settings.XRange != (Range{0.1, 0.3}) || *settings.YRange != (Range{2, 10}) {
+		t.Error("Unexpected ranges ", settings.XRange, settings.YRange)
+	}
+}
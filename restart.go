@@ -0,0 +1,90 @@
+package glot
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// SetAutoRestart turns the automatic restart of gnuplot on or off. It is on by default:
+// when gnuplot died, for instance because it ran out of memory or its window was closed,
+// the next command starts a new gnuplot process and replays the commands sent so far,
+// except the ones writing output files, so that the plot session recovers.
+//
+// Usage
+//  plot.SetAutoRestart(false)
+func (plot *Plot) SetAutoRestart(on bool) {
+	plot.cmdMu.Lock()
+	defer plot.cmdMu.Unlock()
+	plot.noRestart = !on
+}
+
+// canRestart reports whether a failed write to gnuplot should restart it.
+// The commands to gnuplot must be serialized by the caller.
+func (plot *Plot) canRestart() bool {
+	if plot.noRestart || plot.pool != nil || atomic.LoadInt32(&plot.proc.killed) != 0 {
+		return false
+	}
+	return plot.ctx == nil || plot.ctx.Err() == nil
+}
+
+// restart replaces a dead gnuplot process by a new one and replays the first commands
+// of the history, not the command the caller sends again.
+// The commands to gnuplot must be serialized by the caller.
+func (plot *Plot) restart(replayed int) error {
+	old := plot.proc
+	runtime.SetFinalizer(old, nil)
+	old.kill()
+	old.wait()
+	ctx := plot.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	proc, err := newPlotterProc(ctx, old.handle.Path, contains(old.handle.Args, "-persist"), plot.gnuplotArgs()...)
+	if err != nil {
+		return err
+	}
+	plot.proc = proc
+	plot.setFinalizer()
+	commands := replayable(plot.history[:replayed])
+	plot.log(LogWarn, "gnuplot died, restarted it", "replayed", len(commands))
+	for _, cmd := range commands {
+		_, err = proc.write(cmd + "\n")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayable returns the commands of the history a restart sends again: the settings
+// and the latest plot command with the curves added to it, but neither the plot commands
+// it superseded, nor the replots, nor the commands writing output files.
+func replayable(history []string) []string {
+	last := -1
+	for i, cmd := range history {
+		if strings.HasPrefix(cmd, "plot ") || strings.HasPrefix(cmd, "splot ") {
+			last = i
+		}
+	}
+	var commands []string
+	for i, cmd := range history {
+		switch {
+		case writesOutput(cmd), cmd == "replot":
+			continue
+		case strings.HasPrefix(cmd, "plot "), strings.HasPrefix(cmd, "splot "), strings.HasPrefix(cmd, "replot "):
+			if i < last {
+				continue
+			}
+		}
+		commands = append(commands, cmd)
+	}
+	return commands
+}
+
+// writesOutput reports whether a command selects an output file.
+func writesOutput(cmd string) bool {
+	line := strings.SplitN(cmd, "\n", 2)[0]
+	return strings.HasPrefix(line, "set output") && strings.TrimSpace(line[len("set output"):]) != ""
+}
@@ -0,0 +1,55 @@
+package glot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRestart(t *testing.T) {
+	plot, _ := NewPlot(1, false, false)
+	plot.AddPointGroup("Sample1", "points", []float64{1, 2, 4, 11})
+	old := plot.proc
+	old.handle.Process.Kill()
+	var err error
+	// The pipe takes a moment to report the dead process.
+	for i := 0; i < 100 && plot.proc == old; i++ {
+		time.Sleep(10 * time.Millisecond)
+		err = plot.SetTitle("After the crash")
+	}
+	if err != nil || plot.proc == old {
+		t.Error("Expected gnuplot to be restarted, got ", err)
+	}
+	plot.Close()
+}
+
+func TestReplayable(t *testing.T) {
+	history := []string{
+		`set title "Live"`,
+		`plot "a" title "A" with lines`,
+		"replot",
+		`replot "b" title "B" with lines`,
+		`set output "1.png"`,
+		"replot",
+		"set output",
+		`plot "a" title "A" with lines, "b" title "B" with points`,
+		`replot "c" title "C" with lines`,
+		"set grid",
+		"replot",
+	}
+	expected := []string{
+		`set title "Live"`,
+		"set output",
+		`plot "a" title "A" with lines, "b" title "B" with points`,
+		`replot "c" title "C" with lines`,
+		"set grid",
+	}
+	commands := replayable(history)
+	if len(commands) != len(expected) {
+		t.Fatalf("Expected %q, got %q", expected, commands)
+	}
+	for i := range expected {
+		if commands[i] != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], commands[i])
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package glot
+
+import "fmt"
+
+// AddPointGroupFrom adds a PointGroup that draws the data of the PointGroup source in another
+// style, reading the data file or datablock of source instead of writing the data again.
+// This draws a data set several ways, like points with a line and a filled area, with the data
+// sent to gnuplot once. The new PointGroup follows the data of source when it changes,
+// after the plot is drawn again.
+//
+// Usage
+//  plot.AddPointGroup("Samples", "points", [][]float64{x, y})
+//  plot.AddPointGroupFrom("Trend", "lines", "Samples")
+//  plot.AddPointGroupFrom("Band", "filledcurves y1=0", "Samples")
+func (plot *Plot) AddPointGroupFrom(name, style, source string) error {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	if _, exists := plot.PointGroup[name]; exists {
+		return &gnuplotError{err: fmt.Sprintf("A PointGroup with the name %s  already exists, please use another name of the curve or remove this curve before using another one with the same name.", name), kind: ErrDuplicatePointGroup}
+	}
+	src, exists := plot.PointGroup[source]
+	if !exists {
+		return &gnuplotError{err: fmt.Sprintf("A curve with name %s does not exist.", source)}
+	}
+	for src.source != nil {
+		src = src.source
+	}
+	if src.fname == "" {
+		return &gnuplotError{err: fmt.Sprintf("The curve %s has no data file to draw again.", source)}
+	}
+	curve := &PointGroup{
+		name:       name,
+		dimensions: src.dimensions,
+		data:       src.data,
+		castedData: src.castedData,
+		set:        true,
+		pointType:  PointTypePlus,
+		plot:       plot,
+		fname:      src.fname,
+		binary:     src.binary,
+		source:     src,
+	}
+	curve.style = plot.style
+	if style != "" {
+		err := plot.checkStyle(style)
+		if err != nil {
+			return err
+		}
+		curve.style = style
+	}
+	err := plot.plotPointGroup(curve)
+	if err != nil {
+		return err
+	}
+	plot.PointGroup[name] = curve
+	return nil
+}
+
+// sharedData is the data file of the source of a PointGroup added by AddPointGroupFrom,
+// which is not written again.
+type sharedData string
+
+func (data sharedData) Write(p []byte) (int, error)       { return len(p), nil }
+func (data sharedData) WriteString(s string) (int, error) { return len(s), nil }
+func (data sharedData) Name() string                      { return string(data) }
+func (data sharedData) Close() error                      { return nil }
+
+// binaryData reports whether the data of a PointGroup is written in binary format.
+func (plot *Plot) binaryData(pointGroup *PointGroup) bool {
+	if pointGroup.source != nil {
+		return pointGroup.source.binary
+	}
+	return plot.binary && !plot.inline
+}
+
+// sharesData reports whether a PointGroup reads the data file of another PointGroup of the plot.
+func (plot *Plot) sharesData(pointGroup *PointGroup) bool {
+	return pointGroup.source != nil && plot.PointGroup[pointGroup.source.name] == pointGroup.source
+}
+
+// relinkSources makes the copies of the PointGroups of the plot made by Clone,
+// that were added by AddPointGroupFrom, read the data files of the copies of their sources.
+func (plot *Plot) relinkSources(copies map[*PointGroup]*PointGroup) {
+	for original, copied := range copies {
+		if !plot.sharesData(original) {
+			// The source was removed, the copy has a data file of its own.
+			copied.source = nil
+			continue
+		}
+		source := copies[original.source]
+		copied.replaceInSpecs(quote(copied.fname), quote(source.fname), -1)
+		copied.fname = source.fname
+		copied.source = source
+	}
+}
@@ -0,0 +1,33 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddPointGroupFrom(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	plot.AddPointGroup("Samples", "points", [][]float64{{1, 2, 3}, {4, 5, 6}})
+	err = plot.AddPointGroupFrom("Trend", "lines", "Samples")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plot.AddPointGroupFrom("Other", "lines", "Missing") == nil {
+		t.Error("Expected an error for a missing source.")
+	}
+	if len(plot.tmpfiles) != 1 {
+		t.Errorf("Expected 1 data file, got %d.", len(plot.tmpfiles))
+	}
+	fname := quote(plot.PointGroup["Samples"].fname)
+	spec := strings.Join(plot.PointGroup["Trend"].specs, ", ")
+	if !strings.HasPrefix(spec, fname) || !strings.Contains(spec, "with lines") {
+		t.Errorf("Expected Trend to draw %s with lines: %s", fname, spec)
+	}
+	if plot.AppendXY("Trend", 4, 7) == nil {
+		t.Error("Expected an error appending to a curve drawing the data of another.")
+	}
+}
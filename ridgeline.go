@@ -0,0 +1,85 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// RidgelineOptions controls how AddRidgeline draws the distributions.
+type RidgelineOptions struct {
+	Overlap   float64 // Height of the highest curve in distances between the baselines, 1.5 when 0
+	Bandwidth float64 // Bandwidth of the kernel, Silverman's rule of thumb for every distribution when 0
+	Points    int     // Number of points every density is estimated at, 100 when 0
+	Alpha     float64 // Opacity of the fills from 0 to 1, opaque when 0
+}
+
+// AddRidgeline draws the densities of the distributions, estimated with a gaussian kernel,
+// as filled curves stacked on top of each other, the first one on top.
+// Every curve rises from a baseline of its own and overlaps the curves above it,
+// which it hides unless the fills are transparent. The names are the names of the
+// PointGroups of the distributions and label their baselines.
+// The densities are scaled alike, so that their areas are the same.
+//
+// Usage
+//  months := []string{"Jan", "Feb", "Mar"}
+//  plot.AddRidgeline(months, temperatures, glot.RidgelineOptions{Overlap: 2})
+func (plot *Plot) AddRidgeline(names []string, distributions [][]float64, options RidgelineOptions) error {
+	if len(names) != len(distributions) {
+		return &gnuplotError{err: fmt.Sprintf("The number of names and distributions are not same."), kind: ErrLengthMismatch}
+	}
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("Ridgelines must be 2-d plots."), kind: ErrInvalidDimensions}
+	}
+	overlap := options.Overlap
+	if overlap <= 0 {
+		overlap = 1.5
+	}
+	points := options.Points
+	if points < 2 {
+		points = 100
+	}
+
+	xs := make([][]float64, len(distributions))
+	densities := make([][]float64, len(distributions))
+	peak := 0.0
+	for i, values := range distributions {
+		if len(values) == 0 {
+			return &gnuplotError{err: fmt.Sprintf("The distribution %s has no values.", names[i]), kind: ErrLengthMismatch}
+		}
+		xs[i], densities[i] = kernelDensity(values, options.Bandwidth, points)
+		for _, d := range densities[i] {
+			peak = math.Max(peak, d)
+		}
+	}
+	scale := 0.0
+	if peak > 0 {
+		scale = overlap / peak
+	}
+
+	tics := make([]string, len(names))
+	plot.mu.Lock()
+	for i, name := range names {
+		tics[i] = fmt.Sprintf("%s %d", plot.text(name), len(names)-1-i)
+	}
+	plot.mu.Unlock()
+	err := plot.set("ytics", "set ytics (%s)", strings.Join(tics, ", "))
+	if err != nil {
+		return err
+	}
+	// The curves further down are drawn later, in front of the curves above them.
+	for i := range distributions {
+		baseline := float64(len(names) - 1 - i)
+		bottom := make([]float64, points)
+		top := make([]float64, points)
+		for j, d := range densities[i] {
+			bottom[j] = baseline
+			top[j] = baseline + d*scale
+		}
+		err := plot.AddPointGroup(names[i], "filledcurves", AreaData{X: xs[i], Y: bottom, Y2: top, Alpha: options.Alpha})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package glot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddRidgeline(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	err := plot.AddRidgeline([]string{"Jan", "Feb"}, [][]float64{{1, 2, 3}, {4, 5, 5, 6}}, RidgelineOptions{Overlap: 2, Points: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jan := plot.PointGroup["Jan"].castedData.(AreaData)
+	feb := plot.PointGroup["Feb"].castedData.(AreaData)
+	if len(jan.X) != 50 || jan.Y[0] != 1 || feb.Y[0] != 0 {
+		t.Error("Expected Jan above Feb, got the baselines ", jan.Y[0], feb.Y[0])
+	}
+	peak := 0.0
+	for i := range jan.Y2 {
+		peak = math.Max(peak, math.Max(jan.Y2[i]-jan.Y[i], feb.Y2[i]-feb.Y[i]))
+	}
+	if peak < 1.999 || peak > 2.001 {
+		t.Error("Expected the highest curve to be 2 baselines high, got ", peak)
+	}
+	if plot.AddRidgeline([]string{"Mar"}, nil, RidgelineOptions{}) == nil {
+		t.Error("Expected an error for a missing distribution.")
+	}
+}
+
+func TestAddRidgelineKeepsTics(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	plot.AddRidgeline([]string{"Jan", "Feb"}, [][]float64{{1, 2, 3}, {4, 5, 6}}, RidgelineOptions{})
+	if !plot.hasSetting("ytics") {
+		t.Error("Expected the tics of the baselines to be kept, got ", plot.options)
+	}
+}
@@ -0,0 +1,182 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+)
+
+// ScatterData holds points whose color and size can be mapped to other variables.
+type ScatterData struct {
+	X          []float64
+	Y          []float64
+	Color      []float64 // Value mapped to the color of every point, see SetPalette and SetCBRange
+	Size       []float64 // Size of every point, in units of gnuplot's point size
+	MaxSize    float64   // When set, the sizes are scaled so that the areas of the points are proportional to Size and the largest point has this size
+	SizeLegend bool      // Add legend entries showing the size of a few values
+}
+
+// AddPointsWithColor adds points colored by the value c[i] through the palette,
+// to show a metric across 2-d points.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.SetPalette("viridis")
+//  plot.SetCBLabel("latency (ms)")
+//  plot.AddPointsWithColor("Requests", x, y, latency)
+func (plot *Plot) AddPointsWithColor(name string, x, y, c []float64) error {
+	return plot.AddPointGroup(name, "points", ScatterData{X: x, Y: y, Color: c})
+}
+
+// AddBubbles adds a bubble chart, with a point of the size size[i] at x[i], y[i].
+// Use AddPointGroup with ScatterData to scale the sizes and show them in the legend.
+//
+// Usage
+//  plot.AddBubbles("Cities", longitude, latitude, population)
+//  plot.AddPointGroup("Cities", "points", glot.ScatterData{X: longitude, Y: latitude, Size: population, MaxSize: 8, SizeLegend: true})
+func (plot *Plot) AddBubbles(name string, x, y, size []float64) error {
+	return plot.AddPointGroup(name, "points", ScatterData{X: x, Y: y, Size: size})
+}
+
+// bubbleSizes returns the point sizes of the values of a bubble chart and the largest value.
+func bubbleSizes(values []float64, maxSize float64) ([]float64, float64) {
+	largest := 0.0
+	for _, v := range values {
+		largest = math.Max(largest, v)
+	}
+	if maxSize <= 0 || largest <= 0 {
+		return values, largest
+	}
+	sizes := make([]float64, len(values))
+	for i, v := range values {
+		sizes[i] = maxSize * math.Sqrt(math.Max(v, 0)/largest)
+	}
+	return sizes, largest
+}
+
+// SetCBLabel sets the label of the color bar.
+func (plot *Plot) SetCBLabel(label string) error {
+	plot.mu.Lock()
+	text := plot.text(label)
+	plot.mu.Unlock()
+	return plot.set("cblabel", "set cblabel %s", text)
+}
+
+// SetCBRange sets the range of values mapped to the palette, the other values get the color of the closest end.
+func (plot *Plot) SetCBRange(min, max float64) error {
+	return plot.set("cbrange", "set cbrange [%v:%v]", min, max)
+}
+
+func (plot *Plot) plotScatter(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(ScatterData)
+	columns := [][]float64{data.X, data.Y}
+	using := "1:2"
+	var largest float64
+	if data.Size != nil {
+		var sizes []float64
+		sizes, largest = bubbleSizes(data.Size, data.MaxSize)
+		columns = append(columns, sizes)
+		using += fmt.Sprintf(":%d", len(columns))
+	}
+	if data.Color != nil {
+		columns = append(columns, data.Color)
+		using += fmt.Sprintf(":%d", len(columns))
+	}
+	for _, column := range columns[1:] {
+		if len(column) != len(data.X) {
+			return &gnuplotError{err: fmt.Sprintf("The length of the x-axis array and the other arrays are not same.")}
+		}
+	}
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+	err = writeText(f, columns...)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	pointType := pointGroup.pointType
+	if pointType == PointTypePlus {
+		pointType = PointTypeCircleBlack
+	}
+	style := fmt.Sprintf("%s pt %d", pointGroup.style, pointType)
+	if data.Size != nil {
+		style += " ps variable"
+	} else if pointGroup.pointSize > 0 {
+		style += fmt.Sprintf(" ps %.2f", pointGroup.pointSize)
+	}
+	if data.Color != nil {
+		style += " palette"
+	}
+
+	// The legend entries of the sizes plot nothing, they come first so that
+	// the legend shows the sizes above the points.
+	var specs []string
+	if data.SizeLegend && largest > 0 {
+		for _, v := range []float64{largest, largest / 2, largest / 4} {
+			size := v
+			if data.MaxSize > 0 {
+				size = data.MaxSize * math.Sqrt(v/largest)
+			}
+			specs = append(specs, fmt.Sprintf("NaN title \"%v\" with points pt %d ps %v lc rgb \"gray\"", v, pointType, size))
+		}
+	}
+
+	if pointGroup.name == "" {
+		specs = append(specs, fmt.Sprintf("%s using %s with %s", quote(fname), using, style))
+	} else {
+		specs = append(specs, fmt.Sprintf("%s using %s title %s with %s", quote(fname), using, plot.text(pointGroup.name), style))
+	}
+	return plot.sendPlotSpecs(pointGroup, cmd, specs...)
+}
+
+// JitterOptions configures how overlapping points are spread out, see SetJitter.
+type JitterOptions struct {
+	Overlap float64 // Points closer than this many character heights overlap, 1 when 0
+	Spread  float64 // Multiplier of the displacement of the overlapping points, 1 when 0
+	Wrap    float64 // Start again at the original position after this many character widths
+	Mode    string  // Any of swarm (default), square or vertical
+}
+
+// SetJitter displaces overlapping points, so that the points of categorical
+// scatter plots that share an x value become readable.
+// It applies to the point groups drawn with the points style.
+//
+// Usage
+//  plot.SetJitter(glot.JitterOptions{Spread: 0.5, Mode: "square"})
+//  plot.AddPointGroup("Samples", "points", [][]float64{x, y})
+func (plot *Plot) SetJitter(options JitterOptions) error {
+	cmd := "set jitter"
+	if options.Overlap > 0 {
+		cmd += fmt.Sprintf(" overlap %v", options.Overlap)
+	}
+	if options.Spread > 0 {
+		cmd += fmt.Sprintf(" spread %v", options.Spread)
+	}
+	if options.Wrap > 0 {
+		cmd += fmt.Sprintf(" wrap %v", options.Wrap)
+	}
+	switch options.Mode {
+	case "", "swarm":
+	case "square", "vertical":
+		cmd += " " + options.Mode
+	default:
+		return &gnuplotError{err: fmt.Sprintf("The jitter mode %s is not supported.", options.Mode)}
+	}
+	return plot.set("jitter", "%s", cmd)
+}
+
+// UnsetJitter draws overlapping points at their position again.
+func (plot *Plot) UnsetJitter() error {
+	return plot.set("jitter", "unset jitter")
+}
@@ -0,0 +1,50 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddPointsWithColor(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddPointsWithColor("Requests", []float64{1, 2}, []float64{3, 4}, []float64{10, 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec := strings.Join(plot.PointGroup["Requests"].specs, ", "); !strings.HasSuffix(spec, "using 1:2:3 title \"Requests\" with points pt 7 palette") {
+		t.Error("Unexpected spec ", spec)
+	}
+}
+
+func TestBubbleSizes(t *testing.T) {
+	sizes, largest := bubbleSizes([]float64{1, 4}, 10)
+	if largest != 4 || sizes[0] != 5 || sizes[1] != 10 {
+		t.Error("Expected the areas to be proportional to the values, got ", sizes)
+	}
+}
+
+func TestAddBubblesSizeLegend(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	data := ScatterData{X: []float64{1, 2}, Y: []float64{3, 4}, Size: []float64{1, 4}, MaxSize: 10, SizeLegend: true}
+	err := plot.AddPointGroup("Cities", "points", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := strings.Join(plot.PointGroup["Cities"].specs, ", ")
+	if !strings.HasPrefix(spec, "NaN title \"4\" with points pt 7 ps 10") || !strings.Contains(spec, "ps variable") {
+		t.Error("Unexpected spec ", spec)
+	}
+}
+
+func TestSetJitter(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	if err := plot.SetJitter(JitterOptions{Spread: 0.5, Mode: "square"}); err != nil {
+		t.Fatal(err)
+	}
+	if cmd := plot.options[len(plot.options)-1].cmd; cmd != "set jitter spread 0.5 square" {
+		t.Error("Unexpected command ", cmd)
+	}
+	if plot.SetJitter(JitterOptions{Mode: "spiral"}) == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+}
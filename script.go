@@ -0,0 +1,109 @@
+package glot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SetScriptMode turns the script mode of the plot on or off.
+// In script mode the commands are kept in memory instead of being sent to gnuplot
+// one by one, and the whole script is sent at once by Flush or when the plot is saved.
+// This makes the output deterministic and the script easy to inspect with DumpScript.
+// Turning the script mode off sends the pending commands.
+//
+// Usage
+//  plot.SetScriptMode(true)
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  plot.SetTitle("Test Results")
+//  fmt.Println(plot.DumpScript())
+//  plot.SavePlot("1.png")
+func (plot *Plot) SetScriptMode(on bool) error {
+	plot.cmdMu.Lock()
+	plot.scriptMode = on
+	plot.cmdMu.Unlock()
+	if !on {
+		return plot.Flush()
+	}
+	return nil
+}
+
+// Flush sends the commands kept by the script mode to gnuplot in one go.
+// It does nothing when no commands are pending.
+func (plot *Plot) Flush() error {
+	plot.cmdMu.Lock()
+	defer plot.cmdMu.Unlock()
+	if plot.pending.Len() == 0 {
+		return nil
+	}
+	if plot.plotter != nil {
+		err := plot.plotter.SendCommand(strings.TrimSuffix(plot.pending.String(), "\n"))
+		plot.pending.Reset()
+		return err
+	}
+	if plot.proc == nil {
+		// A recording plot, its commands are sent with the plot it belongs to.
+		plot.pending.Reset()
+		return nil
+	}
+	_, err := plot.proc.write(plot.pending.String())
+	plot.pending.Reset()
+	return err
+}
+
+// DumpScript returns every command of the plot as a gnuplot script,
+// including the commands that were already sent to gnuplot.
+func (plot *Plot) DumpScript() string {
+	plot.cmdMu.Lock()
+	defer plot.cmdMu.Unlock()
+	if len(plot.history) == 0 {
+		return ""
+	}
+	return strings.Join(plot.history, "\n") + "\n"
+}
+
+// ExportScript writes the plot as a standalone gnuplot script, plot.gp, to dir
+// together with copies of its data files, so that it can be drawn again without Go.
+// The script refers to the data files by relative paths and is run from dir:
+//  cd dir && gnuplot -persist plot.gp
+// The data of a plot with inline data, see SetInlineData, is written into the script.
+//
+// Usage
+//  plot.AddPointGroup("Sample 1", "lines", []float64{2, 3, 4, 1})
+//  plot.SetTitle("Test Results")
+//  plot.ExportScript("figure1")
+func (plot *Plot) ExportScript(dir string) error {
+	if plot.empty() {
+		return &gnuplotError{err: fmt.Sprintf("This plot has 0 curves and therefore its a redundant plot and it can't be printed.")}
+	}
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	script := strings.Join(append(plot.settings(), plot.plotAll()), "\n") + "\n"
+	exported := make(map[string]bool)
+	for _, pointGroup := range plot.plottedPointGroups() {
+		// Functions have no data file, and the curves of AddPointGroupFrom share theirs.
+		if pointGroup.fname == "" || exported[pointGroup.fname] {
+			continue
+		}
+		// The settings already define the datablocks in the script.
+		if isBlock(pointGroup.fname) {
+			continue
+		}
+		name := fmt.Sprintf("data%d.dat", len(exported))
+		exported[pointGroup.fname] = true
+		data, err := plot.readData(pointGroup.fname)
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(filepath.Join(dir, name), data, 0644)
+		if err != nil {
+			return err
+		}
+		script = strings.Replace(script, quote(pointGroup.fname), quote(name), -1)
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "plot.gp"), []byte(script), 0644)
+}
@@ -0,0 +1,101 @@
+package glot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScriptMode(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	plot.SetScriptMode(true)
+	plot.SetTitle("Test Results")
+	if plot.pending.Len() == 0 {
+		t.Error("Expected the command to be kept until Flush is called.")
+	}
+	plot.Flush()
+	if plot.pending.Len() != 0 {
+		t.Error("Expected Flush to send the pending commands.")
+	}
+}
+
+func TestExportScript(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	plot.SetTitle("Test Results")
+	plot.AddPointGroup("Sample 1", "lines", []float64{2, 3})
+	dir, _ := ioutil.TempDir("", "glot-export")
+	defer os.RemoveAll(dir)
+	err := plot.ExportScript(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "data0.dat"))
+	if err != nil || string(data) != "0 2\n1 3\n" {
+		t.Errorf("Expected a copy of the data file, got %q, %v", data, err)
+	}
+	script, err := ioutil.ReadFile(filepath.Join(dir, "plot.gp"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{`set title "Test Results"`, `plot "data0.dat" using 1:2 title "Sample 1" with lines`} {
+		if !strings.Contains(string(script), expected) {
+			t.Errorf("Expected %q in the script:\n%s", expected, script)
+		}
+	}
+	if strings.Contains(string(script), plot.DataFiles()["Sample 1"]) {
+		t.Errorf("Expected the script to refer to the copy of the data file:\n%s", script)
+	}
+}
+
+func TestExportScriptInlineData(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun(), WithInlineData())
+	defer plot.Close()
+	plot.AddPointGroup("Sample 1", "lines", []float64{2, 3})
+	dir, _ := ioutil.TempDir("", "glot-export")
+	defer os.RemoveAll(dir)
+	err := plot.ExportScript(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "*.dat")); len(files) != 0 {
+		t.Error("Expected no data files for inline data, got ", files)
+	}
+	script, _ := ioutil.ReadFile(filepath.Join(dir, "plot.gp"))
+	plot.AppendPoint("Sample 1", 4)
+	err = plot.ExportScript(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, _ = ioutil.ReadFile(filepath.Join(dir, "plot.gp"))
+	if n := strings.Count(string(script), "$glot_data1 << EOD"); n != 1 {
+		t.Errorf("Expected the datablock to be defined once, got %d definitions:\n%s", n, script)
+	}
+	block := strings.Index(string(script), "$glot_data1 << EOD\n0 2\n1 3\n2 4\nEOD\n")
+	if block < 0 || block > strings.Index(string(script), `plot "$glot_data1"`) {
+		t.Errorf("Expected the datablock to be defined before it is plotted:\n%s", script)
+	}
+}
+
+func TestExportScriptFunctionsAndSharedData(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	plot.AddFunction("Parabola", "x**2", -1, 1, 50)
+	plot.AddPointGroup("Samples", "points", [][]float64{{1, 2}, {3, 4}})
+	plot.AddPointGroupFrom("Line", "lines", "Samples")
+	dir, _ := ioutil.TempDir("", "glot-export")
+	defer os.RemoveAll(dir)
+	err := plot.ExportScript(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.dat"))
+	if len(files) != 1 {
+		t.Error("Expected a single data file for the shared data, got ", files)
+	}
+	script, _ := ioutil.ReadFile(filepath.Join(dir, "plot.gp"))
+	if strings.Count(string(script), `"data0.dat"`) != 2 {
+		t.Errorf("Expected both curves to read data0.dat:\n%s", script)
+	}
+}
@@ -0,0 +1,65 @@
+package glot
+
+import (
+	"fmt"
+)
+
+// AddSeries1D adds a curve of the values y, drawn at x = 0, 1, 2, ... on a 2-d plot
+// or along the x-axis on a 1-d plot.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, false, false)
+//  plot.AddSeries1D("Sample1", "lines", []float64{51, 8, 4, 11})
+func (plot *Plot) AddSeries1D(name string, style string, y []float64) error {
+	if len(y) == 0 {
+		return &gnuplotError{err: fmt.Sprintf("The series %s has no values.", name), kind: ErrLengthMismatch}
+	}
+	if plot.dimensions == 3 {
+		return &gnuplotError{err: fmt.Sprintf("The 1-d series %s can't be drawn on a 3-d plot.", name), kind: ErrInvalidDimensions}
+	}
+	return plot.AddPointGroup(name, style, y)
+}
+
+// AddSeriesXY adds a curve through the points (x[i], y[i]) to a 2-d plot.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, false, false)
+//  plot.AddSeriesXY("Sample1", "lines", []float64{1, 2, 3}, []float64{51, 8, 4})
+func (plot *Plot) AddSeriesXY(name string, style string, x, y []float64) error {
+	if err := checkSeries(name, x, y); err != nil {
+		return err
+	}
+	if plot.dimensions != 2 {
+		return &gnuplotError{err: fmt.Sprintf("The 2-d series %s needs a 2-d plot, not a %d-d plot.", name, plot.dimensions), kind: ErrInvalidDimensions}
+	}
+	return plot.AddPointGroup(name, style, [][]float64{x, y})
+}
+
+// AddSeriesXYZ adds a curve through the points (x[i], y[i], z[i]) to a 3-d plot.
+//
+// Usage
+//  plot, _ := glot.NewPlot(3, false, false)
+//  plot.AddSeriesXYZ("Sample1", "points", []float64{1, 2, 3}, []float64{4, 5, 6}, []float64{7, 8, 9})
+func (plot *Plot) AddSeriesXYZ(name string, style string, x, y, z []float64) error {
+	if err := checkSeries(name, x, y, z); err != nil {
+		return err
+	}
+	if plot.dimensions != 3 {
+		return &gnuplotError{err: fmt.Sprintf("The 3-d series %s needs a 3-d plot, not a %d-d plot.", name, plot.dimensions), kind: ErrInvalidDimensions}
+	}
+	return plot.AddPointGroup(name, style, [][]float64{x, y, z})
+}
+
+// checkSeries checks that the columns of a series are not empty and have the same length.
+func checkSeries(name string, columns ...[]float64) error {
+	axes := "xyz"
+	if len(columns[0]) == 0 {
+		return &gnuplotError{err: fmt.Sprintf("The series %s has no values.", name), kind: ErrLengthMismatch}
+	}
+	for i, column := range columns[1:] {
+		if len(column) != len(columns[0]) {
+			return &gnuplotError{err: fmt.Sprintf("The series %s has %d x values but %d %c values.", name, len(columns[0]), len(column), axes[i+1]), kind: ErrLengthMismatch}
+		}
+	}
+	return nil
+}
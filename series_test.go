@@ -0,0 +1,20 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestAddSeriesXY(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	if err := plot.AddSeriesXY("Sample1", "lines", []float64{1, 2, 3}, []float64{4, 5, 6}); err != nil {
+		t.Fatal(err)
+	}
+	err := plot.AddSeriesXY("Sample2", "lines", []float64{1, 2, 3}, []float64{4, 5})
+	if e, ok := err.(*gnuplotError); !ok || e.kind != ErrLengthMismatch {
+		t.Error("Expected ErrLengthMismatch, got ", err)
+	}
+	err = plot.AddSeriesXYZ("Sample3", "points", []float64{1}, []float64{2}, []float64{3})
+	if e, ok := err.(*gnuplotError); !ok || e.kind != ErrInvalidDimensions {
+		t.Error("Expected ErrInvalidDimensions, got ", err)
+	}
+}
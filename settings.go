@@ -0,0 +1,175 @@
+package glot
+
+import "fmt"
+
+// Settings are the settings of a plot that are kept on the plot itself.
+// They are sent to gnuplot again after ResetPlot and when gnuplot is restarted,
+// together with the other settings made through the methods of the plot,
+// like SetGrid, SetLegend or SetXTics.
+type Settings struct {
+	Title  string
+	XLabel string
+	YLabel string
+	ZLabel string
+	XRange *Range // nil for automatic scaling
+	YRange *Range
+	ZRange *Range
+	Style  string // Style of the PointGroups added with an empty or unknown style
+	Format string // Format the plot is saved in
+}
+
+// Range is the range of an axis.
+type Range struct {
+	Min, Max float64
+}
+
+// setting is a command that is sent to gnuplot again after a reset.
+type setting struct {
+	key string // What the command sets, a later command with the same key replaces it
+	cmd string
+}
+
+// set sends a setting to gnuplot and keeps it on the plot.
+func (plot *Plot) set(key string, format string, a ...interface{}) error {
+	cmd := fmt.Sprintf(format, a...)
+	plot.mu.Lock()
+	plot.keepSetting(key, cmd)
+	plot.mu.Unlock()
+	return plot.Cmd("%s", cmd)
+}
+
+// keepSetting keeps a setting on the plot, for the callers holding mu.
+func (plot *Plot) keepSetting(key string, cmd string) {
+	for i := range plot.options {
+		if plot.options[i].key == key {
+			plot.options[i].cmd = cmd
+			return
+		}
+	}
+	plot.options = append(plot.options, setting{key: key, cmd: cmd})
+}
+
+// hasSetting reports whether a setting is kept on the plot, for the callers holding mu.
+func (plot *Plot) hasSetting(key string) bool {
+	for _, option := range plot.options {
+		if option.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// applySettings sends all settings kept on the plot to gnuplot.
+func (plot *Plot) applySettings() error {
+	plot.mu.Lock()
+	options := make([]setting, len(plot.options))
+	copy(options, plot.options)
+	plot.mu.Unlock()
+	for _, option := range options {
+		err := plot.Cmd("%s", option.cmd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Settings returns the settings of the plot.
+func (plot *Plot) Settings() Settings {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	settings := plot.config
+	settings.Style = plot.style
+	settings.Format = plot.format
+	return settings
+}
+
+// SetSettings applies all the settings at once. Empty fields are left as they are.
+//
+// Usage
+//  plot.SetSettings(glot.Settings{
+//  	Title:  "Requests",
+//  	XLabel: "time",
+//  	YRange: &glot.Range{Min: 0, Max: 100},
+//  })
+func (plot *Plot) SetSettings(settings Settings) error {
+	var err error
+	apply := func(e error) {
+		if err == nil {
+			err = e
+		}
+	}
+	if settings.Title != "" {
+		apply(plot.SetTitle(settings.Title))
+	}
+	if settings.XLabel != "" {
+		apply(plot.SetXLabel(settings.XLabel))
+	}
+	if settings.YLabel != "" {
+		apply(plot.SetYLabel(settings.YLabel))
+	}
+	if settings.ZLabel != "" {
+		apply(plot.SetZLabel(settings.ZLabel))
+	}
+	if settings.XRange != nil {
+		apply(plot.SetXRange(settings.XRange.Min, settings.XRange.Max))
+	}
+	if settings.YRange != nil {
+		apply(plot.SetYRange(settings.YRange.Min, settings.YRange.Max))
+	}
+	if settings.ZRange != nil {
+		apply(plot.SetZRange(settings.ZRange.Min, settings.ZRange.Max))
+	}
+	if settings.Style != "" {
+		plot.SetStyle(settings.Style)
+	}
+	if settings.Format != "" {
+		apply(plot.SetFormat(settings.Format))
+	}
+	return err
+}
+
+// SetStyle sets the style of the PointGroups added afterwards with an empty style,
+// points by default.
+//
+// Usage
+//  plot.SetStyle("lines")
+//  plot.AddPointGroup("Sample 1", "", []float64{2, 3, 4, 1})
+func (plot *Plot) SetStyle(style string) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.style = style
+}
+
+// setRange keeps the range of an axis on the plot and sends it to gnuplot.
+func (plot *Plot) setRange(axis string, r *Range, format string, a ...interface{}) error {
+	plot.mu.Lock()
+	switch axis {
+	case "x":
+		plot.config.XRange = r
+	case "y":
+		plot.config.YRange = r
+	case "z":
+		plot.config.ZRange = r
+	}
+	plot.mu.Unlock()
+	return plot.set(axis+"range", format, a...)
+}
+
+// setLabel keeps a label on the plot and sends it to gnuplot.
+func (plot *Plot) setLabel(key string, label string, options []LabelOptions) error {
+	plot.mu.Lock()
+	switch key {
+	case "title":
+		plot.config.Title = label
+	case "xlabel":
+		plot.config.XLabel = label
+	case "ylabel":
+		plot.config.YLabel = label
+	case "zlabel":
+		plot.config.ZLabel = label
+	}
+	text := plot.text(label)
+	plot.mu.Unlock()
+	return plot.set(key, "set %s %s%s", key, text, labelOptions(key, options))
+}
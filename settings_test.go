@@ -0,0 +1,19 @@
+package glot
+
+import "testing"
+
+func TestResetPlotAppliesSettings(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	plot.SetTitle("First")
+	plot.SetTitle("Second")
+	plot.SetYRange(0, 10)
+	plot.ResetPlot()
+	history := plot.history[len(plot.history)-3:]
+	if history[0] != "reset" || history[1] != "set title \"Second\"" || history[2] != "set yrange [0:10]" {
+		t.Error("Expected the settings after the reset, got ", history)
+	}
+	if settings := plot.Settings(); settings.Title != "Second" || settings.YRange.Max != 10 {
+		t.Error("Unexpected settings ", settings)
+	}
+	plot.Close()
+}
@@ -0,0 +1,222 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Smoothing is how the curve of a PointGroup is smoothed, see SetSmoothing.
+// The constants are gnuplot's smooth options, MovingAverage and Loess are computed in Go.
+type Smoothing string
+
+// The smoothing options of gnuplot.
+const (
+	SmoothNone      Smoothing = ""
+	SmoothCSplines  Smoothing = "csplines"  // Natural cubic splines through the points
+	SmoothACSplines Smoothing = "acsplines" // Approximating cubic splines, weighted by a third column
+	SmoothBezier    Smoothing = "bezier"    // A Bezier curve of the degree of the number of points
+	SmoothSBezier   Smoothing = "sbezier"   // bezier of the unique points
+	SmoothUnique    Smoothing = "unique"    // The mean of the points with the same x, sorted by x
+	SmoothFrequency Smoothing = "frequency" // The sum of the points with the same x, sorted by x
+)
+
+// MovingAverage smooths the curve by the moving average of window points, computed in Go.
+// Unlike SMA the average is centered on each point, so the curve doesn't lag behind.
+// An even window is widened by a point and the window is narrower at the ends of the curve.
+func MovingAverage(window int) Smoothing {
+	return Smoothing(fmt.Sprintf("movingaverage %d", window))
+}
+
+// Loess smooths the curve by locally weighted linear regression computed in Go.
+// The span is the fraction of the points, from 0 to 1, that each fitted value depends on.
+func Loess(span float64) Smoothing {
+	return Smoothing(fmt.Sprintf("loess %v", span))
+}
+
+// SetSmoothing smooths the curve of the PointGroup and redraws the plot.
+// gnuplot's options apply to any data, MovingAverage and Loess to 1-d and 2-d points
+// and change the data written for the curve, the data of the PointGroup stays as it is.
+//
+// Usage
+//  plot.AddPointGroup("Samples", "points", [][]float64{x, y})
+//  plot.AddPointGroupFrom("Trend", "lines", "Samples")
+//  plot.PointGroup["Trend"].SetSmoothing(glot.Loess(0.3))
+func (pointGroup *PointGroup) SetSmoothing(smoothing Smoothing) error {
+	method, param, inGo, err := parseSmoothing(smoothing)
+	if err != nil {
+		return err
+	}
+	plot := pointGroup.plot
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	if inGo {
+		switch data := pointGroup.castedData.(type) {
+		case []float64:
+		case [][]float64:
+			if len(data) != 2 {
+				return &gnuplotError{err: fmt.Sprintf("The %s smoothing only supports 1-d and 2-d points.", method), kind: ErrUnsupportedDataType}
+			}
+		default:
+			return &gnuplotError{err: fmt.Sprintf("The %s smoothing only supports 1-d and 2-d points.", method), kind: ErrUnsupportedDataType}
+		}
+		if param <= 0 || method == "loess" && param > 1 {
+			return &gnuplotError{err: fmt.Sprintf("invalid %s smoothing '%v'", method, param)}
+		}
+		if pointGroup.source != nil {
+			// The smoothed data needs a data file of its own.
+			pointGroup.source = nil
+			pointGroup.fname = ""
+		}
+	}
+	pointGroup.smoothing = smoothing
+	if len(pointGroup.specs) == 0 {
+		return nil
+	}
+	return plot.plotPointGroup(pointGroup)
+}
+
+// parseSmoothing splits a Smoothing into its method and parameter and reports
+// whether it is computed in Go.
+func parseSmoothing(smoothing Smoothing) (string, float64, bool, error) {
+	fields := strings.Fields(string(smoothing))
+	if len(fields) == 0 {
+		return "", 0, false, nil
+	}
+	switch fields[0] {
+	case "movingaverage", "loess":
+		if len(fields) != 2 {
+			break
+		}
+		param, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			break
+		}
+		return fields[0], param, true, nil
+	case "csplines", "acsplines", "bezier", "sbezier", "unique", "frequency":
+		if len(fields) == 1 {
+			return fields[0], 0, false, nil
+		}
+	}
+	return "", 0, false, &gnuplotError{err: fmt.Sprintf("invalid smoothing '%s'", smoothing)}
+}
+
+// smoothOption returns the smooth option of the plot command of the PointGroup, if any.
+func (pointGroup *PointGroup) smoothOption() string {
+	method, _, inGo, err := parseSmoothing(pointGroup.smoothing)
+	if err != nil || inGo || method == "" {
+		return ""
+	}
+	return " smooth " + method
+}
+
+// smoothed returns the columns of 1-d or 2-d points smoothed in Go,
+// or the columns as they are when the PointGroup isn't.
+func (pointGroup *PointGroup) smoothed(columns [][]float64) [][]float64 {
+	method, param, inGo, err := parseSmoothing(pointGroup.smoothing)
+	if err != nil || !inGo {
+		return columns
+	}
+	y := columns[len(columns)-1]
+	x := make([]float64, len(y))
+	if len(columns) == 2 {
+		x = columns[0]
+	} else {
+		for i := range x {
+			x[i] = float64(i)
+		}
+	}
+	var smooth []float64
+	if method == "movingaverage" {
+		smooth = movingAverage(y, int(param))
+	} else {
+		smooth = loess(x, y, param)
+	}
+	return append(append([][]float64{}, columns[:len(columns)-1]...), smooth)
+}
+
+// movingAverage returns the centered moving average of the values over window values,
+// rounded up to an odd number, and over fewer values at the ends so that it stays centered.
+func movingAverage(values []float64, window int) []float64 {
+	sums := make([]float64, len(values)+1)
+	for i, v := range values {
+		sums[i+1] = sums[i] + v
+	}
+	averages := make([]float64, len(values))
+	for i := range values {
+		half := window / 2
+		if i < half {
+			half = i
+		}
+		if len(values)-1-i < half {
+			half = len(values) - 1 - i
+		}
+		averages[i] = (sums[i+half+1] - sums[i-half]) / float64(2*half+1)
+	}
+	return averages
+}
+
+// loess returns the values of y at x fitted by linear regressions over the nearest
+// span*len(x) points, weighted by the tricube of their distance.
+func loess(x, y []float64, span float64) []float64 {
+	n := len(x)
+	fitted := make([]float64, n)
+	if n == 0 {
+		return fitted
+	}
+	order := make(byValue, n)
+	for i := range order {
+		order[i] = indexedValue{i, x[i]}
+	}
+	sort.Sort(order)
+	k := int(math.Ceil(span * float64(n)))
+	if k < 2 {
+		k = 2
+	}
+	if k > n {
+		k = n
+	}
+	lo := 0
+	for _, p := range order {
+		// Slide the window of the k nearest points along the sorted x.
+		for lo+k < n && p.value-order[lo].value > order[lo+k].value-p.value {
+			lo++
+		}
+		dmax := math.Max(p.value-order[lo].value, order[lo+k-1].value-p.value) * 1.001
+		var sw, swx, swy, swxx, swxy float64
+		for _, q := range order[lo : lo+k] {
+			w := 1.0
+			if dmax > 0 {
+				d := math.Abs(q.value-p.value) / dmax
+				w = math.Pow(1-d*d*d, 3)
+			}
+			sw += w
+			swx += w * q.value
+			swy += w * y[q.index]
+			swxx += w * q.value * q.value
+			swxy += w * q.value * y[q.index]
+		}
+		denom := sw*swxx - swx*swx
+		if math.Abs(denom) < 1e-12*sw*sw {
+			fitted[p.index] = swy / sw
+			continue
+		}
+		slope := (sw*swxy - swx*swy) / denom
+		fitted[p.index] = (swy-slope*swx)/sw + slope*p.value
+	}
+	return fitted
+}
+
+// indexedValue is a value with its position, to sort values and put them back.
+type indexedValue struct {
+	index int
+	value float64
+}
+
+type byValue []indexedValue
+
+func (s byValue) Len() int           { return len(s) }
+func (s byValue) Less(i, j int) bool { return s[i].value < s[j].value }
+func (s byValue) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,44 @@
+package glot
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSmoothing(t *testing.T) {
+	line := []float64{1, 3, 5, 7, 9, 11}
+	x := []float64{0, 1, 2, 3, 4, 5}
+	for name, smooth := range map[string][]float64{
+		"movingAverage": movingAverage(line, 3),
+		"loess":         loess(x, line, 0.5),
+	} {
+		for i := range line {
+			if math.Abs(smooth[i]-line[i]) > 1e-9 {
+				t.Errorf("%s of a line = %v", name, smooth)
+				break
+			}
+		}
+	}
+	if avg := movingAverage([]float64{0, 3, 0, 3}, 2); avg[0] != 0 || avg[1] != 1 {
+		t.Errorf("movingAverage = %v", avg)
+	}
+
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	plot.AddPointGroup("Samples", "lines", [][]float64{x, line})
+	pointGroup := plot.PointGroup["Samples"]
+	if pointGroup.SetSmoothing("splines") == nil {
+		t.Error("Expected an error for an unknown smoothing.")
+	}
+	pointGroup.SetSmoothing(SmoothCSplines)
+	if !strings.HasSuffix(plot.DumpScript(), " smooth csplines\n") {
+		t.Errorf("Expected smooth csplines at the end of the script:\n%s", plot.DumpScript())
+	}
+	if pointGroup.SetSmoothing(Loess(2)) == nil {
+		t.Error("Expected an error for a span larger than 1.")
+	}
+}
@@ -0,0 +1,124 @@
+package glot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ColumnStats holds the statistics of a column of data, see Stats.
+type ColumnStats struct {
+	Min           float64
+	Max           float64
+	Mean          float64
+	StdDev        float64
+	Median        float64
+	LowerQuartile float64
+	UpperQuartile float64
+	Sum           float64
+}
+
+// StatsResult holds the statistics gnuplot's stats command computed for the data of a PointGroup.
+type StatsResult struct {
+	Records int         // Number of points
+	X       ColumnStats // Statistics of x, of 2-d points only
+	Y       ColumnStats // Statistics of y, the values of 1-d points
+}
+
+// statsMarker starts the lines with the statistics printed by gnuplot.
+const statsMarker = "glot-stats "
+
+// statsFields are the names of the variables of gnuplot's stats command for ColumnStats.
+var statsFields = []string{"min", "max", "mean", "stddev", "median", "lo_quartile", "up_quartile", "sum"}
+
+// Stats returns the statistics of the data of a 1-d or 2-d PointGroup computed by gnuplot's
+// stats command, for instance to set ranges or annotate the plot.
+// It runs in a gnuplot process of its own.
+//
+// Usage
+//  plot.AddPointGroup("Latency", "points", [][]float64{t, ms})
+//  stats, _ := plot.Stats("Latency")
+//  plot.AddHLine(stats.Y.Median, "dt 2")
+func (plot *Plot) Stats(name string) (*StatsResult, error) {
+	err := plot.requireFeature("stats")
+	if err != nil {
+		return nil, err
+	}
+	plot.mu.Lock()
+	pointGroup := plot.PointGroup[name]
+	plot.mu.Unlock()
+	if pointGroup == nil {
+		return nil, &gnuplotError{err: fmt.Sprintf("A curve with name %s does not exist.", name)}
+	}
+	source, columns, commands, err := plot.dataSource(pointGroup)
+	if err != nil {
+		return nil, err
+	}
+	using := "2"
+	suffixes := []string{""}
+	if columns == 2 {
+		using = "1:2"
+		suffixes = []string{"_x", "_y"}
+	}
+	commands = append(commands, fmt.Sprintf(`stats %s using %s name "glot" nooutput`, source, using),
+		`set print "-"`,
+		fmt.Sprintf(`print sprintf("%srecords %%d", glot_records)`, statsMarker))
+	for _, suffix := range suffixes {
+		for _, field := range statsFields {
+			commands = append(commands, fmt.Sprintf(`print sprintf("%s%s%s %%.17g", glot_%s%s)`, statsMarker, field, suffix, field, suffix))
+		}
+	}
+
+	ctx := plot.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var out bytes.Buffer
+	err = plot.runScript(ctx, commands, &out)
+	if err != nil {
+		return nil, err
+	}
+	return parseStats(&out, columns)
+}
+
+// parseStats reads the statistics printed by the stats script.
+func parseStats(out *bytes.Buffer, columns int) (*StatsResult, error) {
+	values := make(map[string]float64)
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(strings.TrimPrefix(line, statsMarker))
+		if !strings.HasPrefix(line, statsMarker) || len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err == nil {
+			values[fields[0]] = value
+		}
+	}
+	if _, ok := values["records"]; !ok {
+		return nil, &gnuplotError{err: "gnuplot printed no statistics."}
+	}
+	column := func(suffix string) ColumnStats {
+		return ColumnStats{
+			Min:           values["min"+suffix],
+			Max:           values["max"+suffix],
+			Mean:          values["mean"+suffix],
+			StdDev:        values["stddev"+suffix],
+			Median:        values["median"+suffix],
+			LowerQuartile: values["lo_quartile"+suffix],
+			UpperQuartile: values["up_quartile"+suffix],
+			Sum:           values["sum"+suffix],
+		}
+	}
+	result := &StatsResult{Records: int(values["records"])}
+	if columns == 2 {
+		result.X, result.Y = column("_x"), column("_y")
+	} else {
+		result.Y = column("")
+	}
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package glot
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseStats(t *testing.T) {
+	out := bytes.NewBufferString("glot-stats records 4\nglot-stats min_x 1\nglot-stats max_x 4\n" +
+		"glot-stats median_y 2.5\nglot-stats up_quartile_y 3.5\nwarning: something\n")
+	stats, err := parseStats(out, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Records != 4 || stats.X.Min != 1 || stats.X.Max != 4 || stats.Y.Median != 2.5 || stats.Y.UpperQuartile != 3.5 {
+		t.Errorf("parseStats = %+v", stats)
+	}
+	if _, err := parseStats(bytes.NewBufferString(""), 1); err == nil {
+		t.Error("Expected an error without statistics.")
+	}
+
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	if _, err := plot.Stats("Missing"); err == nil {
+		t.Error("Expected an error for a missing PointGroup.")
+	}
+}
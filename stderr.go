@@ -0,0 +1,179 @@
+package glot
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// syncMarker is printed by gnuplot after each command, so that the messages
+// read from its standard error can be associated to the command that caused them.
+const syncMarker = "glot-sync "
+
+// errorsTimeout bounds how long Errors waits for gnuplot to catch up.
+const errorsTimeout = time.Second
+
+// CommandError is an error or warning gnuplot printed for a command.
+type CommandError struct {
+	Command string // The command as it was sent to gnuplot
+	Message string // What gnuplot printed about it
+}
+
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("gnuplot: %s: %s", e.Command, e.Message)
+}
+
+// commandLog collects the messages gnuplot prints on its standard error.
+type commandLog struct {
+	mu   sync.Mutex
+	seq  int            // Number of the last command sent
+	seen int            // Number of the last command gnuplot finished
+	sent map[int]string // Commands sent and not finished yet
+	errs []error        // Errors not returned by Errors yet
+	log  Logger         // Receives the errors as they are read, if set
+	done chan struct{}  // Closed when the standard error is closed
+}
+
+func newCommandLog() *commandLog {
+	return &commandLog{sent: make(map[int]string), done: make(chan struct{})}
+}
+
+// write sends a command to gnuplot followed by its sync marker.
+func (proc *plotterProcess) write(cmd string) (int, error) {
+	log := proc.errlog
+	log.mu.Lock()
+	log.seq++
+	seq := log.seq
+	log.sent[seq] = strings.TrimSuffix(cmd, "\n")
+	log.mu.Unlock()
+	return io.WriteString(proc.stdin, fmt.Sprintf("%sprint \"%s%d\"\n", cmd, syncMarker, seq))
+}
+
+// read reads the standard error of gnuplot until it is closed.
+func (log *commandLog) read(stderr io.Reader) {
+	defer close(log.done)
+	var message []string
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, syncMarker) {
+			seq, _ := strconv.Atoi(strings.TrimPrefix(line, syncMarker))
+			log.finish(seq, message)
+			message = nil
+			continue
+		}
+		// Skip the echo of the command and the caret pointing in it.
+		if line == "" || line == "^" || strings.HasPrefix(line, "gnuplot>") {
+			continue
+		}
+		message = append(message, line)
+	}
+	log.mu.Lock()
+	seq := log.seq
+	log.mu.Unlock()
+	log.finish(seq, message)
+}
+
+// finish records the messages of the command seq and of the ones before it.
+func (log *commandLog) finish(seq int, message []string) {
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	if len(message) > 0 {
+		err := &CommandError{Command: log.sent[seq], Message: strings.Join(message, "; ")}
+		log.errs = append(log.errs, err)
+		if log.log != nil {
+			log.log.Log(LogWarn, "gnuplot error", "cmd", err.Command, "message", err.Message)
+		}
+	}
+	for n := log.seen + 1; n <= seq; n++ {
+		delete(log.sent, n)
+	}
+	if seq > log.seen {
+		log.seen = seq
+	}
+}
+
+// setLogger sets the logger receiving the errors.
+func (log *commandLog) setLogger(logger Logger) {
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	log.log = logger
+}
+
+// catchUp waits until gnuplot finished the commands sent so far, or errorsTimeout elapsed.
+func (log *commandLog) catchUp() {
+	deadline := time.Now().Add(errorsTimeout)
+	for {
+		log.mu.Lock()
+		caughtUp := log.seen >= log.seq
+		log.mu.Unlock()
+		if caughtUp || time.Now().After(deadline) {
+			break
+		}
+		select {
+		case <-log.done:
+			deadline = time.Now()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// wait waits until gnuplot finished the commands sent so far, or the context is done.
+func (log *commandLog) wait(ctx context.Context) error {
+	for {
+		log.mu.Lock()
+		caughtUp := log.seen >= log.seq
+		log.mu.Unlock()
+		if caughtUp {
+			return nil
+		}
+		select {
+		case <-log.done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// take waits for gnuplot to catch up, then returns the errors collected.
+func (log *commandLog) take() []error {
+	log.catchUp()
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	errs := log.errs
+	log.errs = nil
+	return errs
+}
+
+// reset waits for gnuplot to catch up, then drops the errors collected
+// and the logger, for a process that is handed to another plot.
+func (log *commandLog) reset() {
+	log.catchUp()
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	log.errs = nil
+	log.log = nil
+}
+
+// Errors returns the errors and warnings gnuplot printed since the last call,
+// as *CommandError values holding the command that caused them.
+// It first waits a moment for gnuplot to process the commands sent so far.
+//
+// Usage
+//  plot.Cmd("set xrange [0:")
+//  for _, err := range plot.Errors() {
+//  	log.Println(err)
+//  }
+func (plot *Plot) Errors() []error {
+	if plot.proc == nil || plot.proc.errlog == nil {
+		return nil
+	}
+	return plot.proc.errlog.take()
+}
@@ -0,0 +1,22 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommandLog(t *testing.T) {
+	log := newCommandLog()
+	log.seq = 2
+	log.sent[1] = "set xrange [0:"
+	log.sent[2] = "set title 'ok'"
+	stderr := "\ngnuplot> set xrange [0:\n                       ^\n         line 0: ':' expected\n\nglot-sync 1\nglot-sync 2\n"
+	log.read(strings.NewReader(stderr))
+	errs := log.take()
+	if len(errs) != 1 || errs[0].(*CommandError).Command != "set xrange [0:" {
+		t.Fatal("Expected one error for the first command, got ", errs)
+	}
+	if msg := errs[0].(*CommandError).Message; msg != "line 0: ':' expected" {
+		t.Error("Unexpected message ", msg)
+	}
+}
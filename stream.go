@@ -0,0 +1,172 @@
+package glot
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// AppendPoint appends values to a 1-d PointGroup.
+// The values are appended to the data file of the PointGroup, so the plot
+// only needs to be redrawn with Refresh to show them.
+//
+// Usage
+//  dimensions := 1
+//  persist := true
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddPointGroup("Live", "lines", []float64{1, 2})
+//  plot.AppendPoint("Live", 3, 4)
+//  plot.Refresh()
+func (plot *Plot) AppendPoint(name string, values ...float64) error {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	pointGroup, exists := plot.PointGroup[name]
+	if !exists {
+		return &gnuplotError{err: fmt.Sprintf("A curve with name %s does not exist.", name)}
+	}
+	if _, ok := pointGroup.castedData.([]float64); !ok {
+		return &gnuplotError{err: fmt.Sprintf("AppendPoint only supports 1-d PointGroups."), kind: ErrInvalidDimensions}
+	}
+	data := pointGroup.appendable().([]float64)
+	rows := make([][]float64, len(values))
+	for i, v := range values {
+		rows[i] = []float64{float64(len(data) + i), v}
+	}
+	err := appendRows(pointGroup, rows)
+	if err != nil {
+		return err
+	}
+	pointGroup.castedData = append(data, values...)
+	return nil
+}
+
+// AppendXY appends a point to a 2-d PointGroup.
+//
+// Usage
+//  plot.AddPointGroup("Live", "lines", [][]float64{{1, 2}, {5, 3}})
+//  plot.AppendXY("Live", 3, 8)
+//  plot.Refresh()
+func (plot *Plot) AppendXY(name string, x, y float64) error {
+	return plot.appendPoint(name, x, y)
+}
+
+// AppendXYZ appends a point to a 3-d PointGroup.
+//
+// Usage
+//  plot.AddPointGroup("Live", "lines", [][]float64{{1, 2}, {5, 3}, {0, 1}})
+//  plot.AppendXYZ("Live", 3, 8, 2)
+//  plot.Refresh()
+func (plot *Plot) AppendXYZ(name string, x, y, z float64) error {
+	return plot.appendPoint(name, x, y, z)
+}
+
+func (plot *Plot) appendPoint(name string, point ...float64) error {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	pointGroup, exists := plot.PointGroup[name]
+	if !exists {
+		return &gnuplotError{err: fmt.Sprintf("A curve with name %s does not exist.", name)}
+	}
+	if data, ok := pointGroup.castedData.([][]float64); !ok || len(data) != len(point) {
+		return &gnuplotError{err: fmt.Sprintf("The dimensions of this point are not compatible with the dimensions of the PointGroup."), kind: ErrInvalidDimensions}
+	}
+	data := pointGroup.appendable().([][]float64)
+	err := appendRows(pointGroup, [][]float64{point})
+	if err != nil {
+		return err
+	}
+	appended := make([][]float64, len(data))
+	for i := range data {
+		appended[i] = append(data[i], point[i])
+	}
+	pointGroup.castedData = appended
+	return nil
+}
+
+// appendable returns the data of the PointGroup to append to, copied first while it
+// may still be the slices of the caller, whose arrays must not be written to.
+func (pointGroup *PointGroup) appendable() interface{} {
+	if !pointGroup.owned {
+		pointGroup.castedData = copyData(pointGroup.castedData)
+		pointGroup.owned = true
+	}
+	return pointGroup.castedData
+}
+
+// appendRows appends the rows to the data file or datablock of a PointGroup.
+func appendRows(pointGroup *PointGroup, rows [][]float64) error {
+	if pointGroup.source != nil {
+		return &gnuplotError{err: fmt.Sprintf("The curve %s draws the data of %s, append to that curve instead.", pointGroup.name, pointGroup.source.name)}
+	}
+	if isBlock(pointGroup.fname) {
+		return pointGroup.plot.appendBlock(pointGroup, rows)
+	}
+	f, err := os.OpenFile(pointGroup.fname, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	write := writeText
+	if pointGroup.binary {
+		write = writeBinary
+	}
+	for _, row := range rows {
+		columns := make([][]float64, len(row))
+		for i := range row {
+			columns[i] = row[i : i+1]
+		}
+		err = write(f, columns...)
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
+
+// Refresh redraws the plot so that appended points become visible.
+func (plot *Plot) Refresh() error {
+	return plot.Cmd("replot")
+}
+
+// StartRefresh redraws the plot on every interval until StopRefresh is called.
+// This is useful for live plots that are fed with AppendPoint, AppendXY or AppendXYZ.
+//
+// Usage
+//  plot.StartRefresh(time.Second)
+//  defer plot.StopRefresh()
+func (plot *Plot) StartRefresh(interval time.Duration) {
+	plot.every(interval, func() { plot.Refresh() })
+}
+
+// every calls tick on every interval until StopRefresh is called,
+// replacing the loop of an earlier call.
+func (plot *Plot) every(interval time.Duration, tick func()) {
+	plot.StopRefresh()
+	stop := make(chan struct{})
+	plot.mu.Lock()
+	plot.refresh = stop
+	plot.mu.Unlock()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				tick()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// StopRefresh stops the refresh loop started by StartRefresh.
+func (plot *Plot) StopRefresh() {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	if plot.refresh != nil {
+		close(plot.refresh)
+		plot.refresh = nil
+	}
+}
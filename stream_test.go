@@ -0,0 +1,53 @@
+package glot
+
+import "testing"
+
+func TestAppendXY(t *testing.T) {
+	dimensions := 2
+	persist := false
+	debug := false
+	plot, _ := NewPlot(dimensions, persist, debug)
+	plot.AddPointGroup("Live", "lines", [][]float64{{1, 2}, {5, 3}})
+	err := plot.AppendXY("Live", 3, 8)
+	if err != nil {
+		t.Error("Expected the point to be appended, got ", err)
+	}
+	if n := len(plot.PointGroup["Live"].castedData.([][]float64)[0]); n != 3 {
+		t.Error("Expected 3 points, got ", n)
+	}
+}
+
+func TestAppendKeepsCallerData(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	x := make([]float64, 2, 10)
+	y := append(make([]float64, 0, 10), 5, 3)
+	plot.AddPointGroup("Live", "lines", [][]float64{x, y})
+	plot.AppendXY("Live", 3, 8)
+	if x[:3][2] != 0 || y[:3][2] != 0 {
+		t.Error("Expected the slices of the caller to be unchanged, got ", x[:3], y[:3])
+	}
+	values := make([]float64, 2, 10)
+	plot.AddPointGroup("Values", "lines", values)
+	plot.AppendPoint("Values", 7)
+	if values[:3][2] != 0 || len(plot.PointGroup["Values"].castedData.([]float64)) != 3 {
+		t.Error("Expected the slice of the caller to be unchanged, got ", values[:3])
+	}
+}
+
+func TestRefreshKeepsHistoryBounded(t *testing.T) {
+	for _, inline := range []bool{false, true} {
+		plot, _ := NewPlotWithOptions(WithDryRun())
+		plot.SetInlineData(inline)
+		plot.AddPointGroup("Live", "lines", [][]float64{{0}, {1}})
+		plot.Refresh()
+		n := len(plot.CommandHistory())
+		for i := 1; i <= 1000; i++ {
+			plot.AppendXY("Live", float64(i), float64(i))
+			plot.Refresh()
+		}
+		if history := plot.CommandHistory(); len(history) != n {
+			t.Errorf("Expected the history to stay at %d commands with inline data %v, got %d", n, inline, len(history))
+		}
+		plot.Close()
+	}
+}
@@ -0,0 +1,110 @@
+package glot
+
+import (
+	"fmt"
+)
+
+// commonStyles are the styles of both 2-d and 3-d plots.
+// heatmap is not a gnuplot style, glot draws matrices with it as an image.
+var commonStyles = []string{
+	"lines", "points", "linespoints", "lp", "linepoints",
+	"impulses", "dots", "labels", "vectors", "image", "rgbimage", "heatmap",
+}
+
+// planeStyles are the styles of 1-d and 2-d plots only.
+var planeStyles = []string{
+	"steps", "fsteps", "histeps", "fillsteps",
+	"boxes", "boxerrorbars", "boxxyerror", "boxplot",
+	"candlesticks", "financebars", "circles", "ellipses", "filledcurves", "histograms",
+	"errorbars", "xerrorbars", "yerrorbars", "xyerrorbars",
+	"errorlines", "xerrorlines", "yerrorlines", "xyerrorlines",
+	"bar", "fill solid", "histogram", "circle",
+}
+
+// spaceStyles are the styles of 3-d plots only.
+var spaceStyles = []string{"pm3d", "zerrorfill", "boxes", "circles"}
+
+// knownStyles returns the styles glot accepts for a plot of the given dimensions.
+func knownStyles(dimensions int) []string {
+	styles := append([]string{}, commonStyles...)
+	if dimensions == 3 {
+		return append(styles, spaceStyles...)
+	}
+	return append(styles, planeStyles...)
+}
+
+// SetStylePassthrough lets AddPointGroup and ResetPointGroupStyle send styles glot
+// doesn't know to gnuplot as they are, for exotic styles or styles with options
+// like "filledcurves above y=0". It is off by default, unknown styles are an error.
+//
+// Usage
+//  plot.SetStylePassthrough(true)
+//  plot.AddPointGroup("Sample1", "filledcurves above y=0", [][]float64{x, y})
+func (plot *Plot) SetStylePassthrough(on bool) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.passthru = on
+}
+
+// checkStyle returns an ErrUnknownStyle error suggesting the closest known style
+// when the style is not known for the dimensions of the plot. The caller holds mu.
+func (plot *Plot) checkStyle(style string) error {
+	if plot.passthru {
+		return nil
+	}
+	styles := knownStyles(plot.dimensions)
+	if contains(styles, style) {
+		return nil
+	}
+	msg := fmt.Sprintf("The style %q is unknown for a %d-d plot", style, plot.dimensions)
+	if suggestion := closestStyle(style, styles); suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	return &gnuplotError{err: msg, kind: ErrUnknownStyle}
+}
+
+// closestStyle returns the style with the smallest edit distance to style,
+// or "" when none is close enough to be a likely typo.
+func closestStyle(style string, styles []string) string {
+	best, bestDistance := "", len(style)/3+1
+	if bestDistance < 3 {
+		bestDistance = 3
+	}
+	for _, s := range styles {
+		if d := editDistance(style, s); d < bestDistance {
+			best, bestDistance = s, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,24 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnknownStyle(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddPointGroup("Sample1", "lines poinsts", []float64{1, 2, 3})
+	if e, ok := err.(*gnuplotError); !ok || e.kind != ErrUnknownStyle || !strings.Contains(e.err, `did you mean "linespoints"?`) {
+		t.Error("Expected an ErrUnknownStyle suggesting linespoints, got ", err)
+	}
+	if _, exists := plot.PointGroup["Sample1"]; exists {
+		t.Error("The PointGroup with an unknown style should not be added")
+	}
+	if plot.AddPointGroup("Sample2", "pm3d", []float64{1, 2, 3}) == nil {
+		t.Error("Expected pm3d to be unknown on a 2-d plot")
+	}
+	plot.SetStylePassthrough(true)
+	if err := plot.AddPointGroup("Sample3", "filledcurves above y=0", [][]float64{{1, 2}, {3, 4}}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,105 @@
+package glot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AddSurface adds a 3-d surface of the format z = Function(x,y) that is sampled on a grid.
+// The surface is drawn with pm3d, use AddPointGroup with SurfaceData and the
+// "lines" style to draw a mesh instead.
+//
+// Usage
+//  dimensions := 3
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  xs := []float64{0, 1, 2}
+//  ys := []float64{0, 1}
+//  z := [][]float64{{0, 1, 4}, {1, 2, 5}}
+//  plot.AddSurface("Bowl", xs, ys, z)
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddSurface(name string, xs, ys []float64, z [][]float64) error {
+	return plot.AddPointGroup(name, "pm3d", SurfaceData{X: xs, Y: ys, Z: z})
+}
+
+// SetDgrid3d makes gnuplot interpolate scattered 3-d points onto a grid of
+// rows x cols, so they can be drawn as a surface.
+// The method is one of the dgrid3d smoothing methods like "splines", "qnorm 2"
+// or "gauss 0.5,0.5", qnorm is used when it is empty.
+//
+// Usage
+//  plot.SetDgrid3d(30, 30, "splines")
+//  plot.AddPointGroup("Scattered", "lines", [][]float64{x, y, z})
+func (plot *Plot) SetDgrid3d(rows, cols int, method string) error {
+	return plot.set("dgrid3d", "set dgrid3d %d,%d %s", rows, cols, method)
+}
+
+// UnsetDgrid3d turns off the interpolation enabled by SetDgrid3d.
+func (plot *Plot) UnsetDgrid3d() error {
+	return plot.set("dgrid3d", "unset dgrid3d")
+}
+
+// ContourOptions controls the contour lines drawn by AddContour.
+// The levels are taken from Discrete when set, else from Increment when it is not 0,
+// else gnuplot picks about Levels levels.
+type ContourOptions struct {
+	Levels    int       // Approximate number of automatic levels, 5 when 0
+	Start     float64   // First level of incremental levels
+	Increment float64   // Step between incremental levels
+	End       float64   // Last level of incremental levels
+	Discrete  []float64 // Explicit levels
+	Labels    bool      // List the levels of the contour lines in the key
+	Filled    bool      // Color the area between the contour lines with pm3d
+}
+
+// AddContour draws the contour lines of a surface, seen from above.
+//
+// Usage
+//  dimensions := 3
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  xs := []float64{0, 1, 2}
+//  ys := []float64{0, 1}
+//  z := [][]float64{{0, 1, 4}, {1, 2, 5}}
+//  plot.AddContour("Heights", xs, ys, z, glot.ContourOptions{Increment: 1, End: 5, Labels: true})
+//  plot.SavePlot("1.png")
+func (plot *Plot) AddContour(name string, xs, ys []float64, z [][]float64, options ContourOptions) error {
+	var levels string
+	switch {
+	case options.Discrete != nil:
+		values := make([]string, len(options.Discrete))
+		for i, v := range options.Discrete {
+			values[i] = fmt.Sprintf("%v", v)
+		}
+		levels = "discrete " + strings.Join(values, ",")
+	case options.Increment != 0:
+		levels = fmt.Sprintf("incremental %v,%v,%v", options.Start, options.Increment, options.End)
+	default:
+		n := options.Levels
+		if n == 0 {
+			n = 5
+		}
+		levels = fmt.Sprintf("auto %d", n)
+	}
+
+	commands := []setting{{"contour", "set contour base"}, {"cntrparam", "set cntrparam levels " + levels},
+		{"view", "set view map"}, {"clabel", "unset clabel"}, {"surface", "set surface"}}
+	if options.Labels {
+		commands[3].cmd = "set clabel"
+	}
+	style := "pm3d"
+	if !options.Filled {
+		// Only the contour lines are drawn, not the surface itself.
+		commands[4].cmd = "unset surface"
+		style = "lines"
+	}
+	for _, command := range commands {
+		err := plot.set(command.key, "%s", command.cmd)
+		if err != nil {
+			return err
+		}
+	}
+	return plot.AddPointGroup(name, style, SurfaceData{X: xs, Y: ys, Z: z})
+}
@@ -0,0 +1,85 @@
+package glot
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestAddContour(t *testing.T) {
+	plot, _ := NewPlot(3, false, false)
+	z := [][]float64{{0, 1, 4}, {1, 2, 5}}
+	err := plot.AddContour("Heights", []float64{0, 1, 2}, []float64{0, 1}, z, ContourOptions{Increment: 1, End: 5, Labels: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"set contour base", "set cntrparam levels incremental 0,1,5", "set view map", "set clabel", "unset surface"}
+	if len(plot.options) != len(expected) {
+		t.Fatal("Expected the contour settings to be kept, got ", plot.options)
+	}
+	for i, cmd := range expected {
+		if plot.options[i].cmd != cmd {
+			t.Errorf("Expected %q, got %q", cmd, plot.options[i].cmd)
+		}
+	}
+	plot.ResetPlot()
+	if history := plot.history[len(plot.history)-1]; history != "unset surface" {
+		t.Error("Expected the contour settings after a reset, got ", history)
+	}
+	plot.Close()
+}
+
+func TestAddSurface(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDimensions(3), WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	err = plot.AddSurface("Heights", []float64{0, 1}, []float64{0, 1}, [][]float64{{0, 1}, {2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fname := plot.DataFiles()["Heights"]
+	data, err := ioutil.ReadFile(fname)
+	if err != nil || string(data) != "0 0 0\n1 0 1\n\n0 1 2\n1 1 3\n" {
+		t.Errorf("Expected every row of the grid in a block of its own, got %q, %v", data, err)
+	}
+	if script := plot.DumpScript(); !strings.Contains(script, "splot "+quote(fname)+` title "Heights" with pm3d`) {
+		t.Errorf("Expected the surface to be drawn with pm3d:\n%s", script)
+	}
+
+	err = plot.AddSurface("Ragged", []float64{0, 1}, []float64{0, 1}, [][]float64{{0, 1}, {2}})
+	if !errors.Is(err, ErrInvalidDimensions) {
+		t.Error("Expected ErrInvalidDimensions for a ragged grid, got ", err)
+	}
+	plot2d, _ := NewPlotWithOptions(WithDryRun())
+	defer plot2d.Close()
+	err = plot2d.AddSurface("Flat", []float64{0}, []float64{0}, [][]float64{{0}})
+	if err == nil {
+		t.Error("Expected an error for a surface on a 2-d plot")
+	}
+}
+
+func TestSetDgrid3d(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDimensions(3), WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	err = plot.SetDgrid3d(30, 20, "splines")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history := plot.history[len(plot.history)-1]; history != "set dgrid3d 30,20 splines" {
+		t.Error("Expected the dgrid3d command, got ", history)
+	}
+	plot.ResetPlot()
+	if history := plot.history[len(plot.history)-1]; history != "set dgrid3d 30,20 splines" {
+		t.Error("Expected dgrid3d to be set again after a reset, got ", history)
+	}
+	plot.UnsetDgrid3d()
+	if !plot.hasSetting("dgrid3d") || plot.history[len(plot.history)-1] != "unset dgrid3d" {
+		t.Error("Expected dgrid3d to be unset, got ", plot.history[len(plot.history)-1])
+	}
+}
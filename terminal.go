@@ -0,0 +1,133 @@
+package glot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TerminalOptions are the options of the terminal the plot is saved with.
+// Options that don't apply to the terminal of the format are ignored.
+type TerminalOptions struct {
+	Width       float64 // Width of the image, in pixels for png, pngcairo, svg, canvas and webp, in inches for the others
+	Height      float64 // Height of the image, in the same unit as Width
+	DPI         float64 // When set, Width and Height are in pixels for all formats and converted to inches with DPI
+	Font        string  // Font of the text, like "Helvetica,12"
+	FontScale   float64 // Scale of all text, for the terminals that support it
+	Standalone  bool    // Make cairolatex and tikz output a complete LaTeX document instead of a file to \input
+	Transparent bool    // Leave the background of png and pngcairo images transparent
+	Extra       string  // Any other options, passed to the terminal as they are
+}
+
+// terminals maps the formats to their gnuplot terminal.
+var terminals = map[string]string{
+	"png":        "png",
+	"pdf":        "pdf",
+	"svg":        "svg",
+	"eps":        "epscairo",
+	"pdfcairo":   "pdfcairo",
+	"pngcairo":   "pngcairo",
+	"cairolatex": "cairolatex pdf",
+	"tikz":       "tikz",
+	"canvas":     "canvas",
+	"webp":       "webp",
+}
+
+// rasterFormats are the formats with sizes in pixels.
+var rasterFormats = map[string]bool{"png": true, "pngcairo": true, "svg": true, "canvas": true, "webp": true, "gif": true, "sixelgd": true}
+
+// fontScaleFormats are the formats whose terminal supports fontscale.
+var fontScaleFormats = map[string]bool{"pdf": true, "svg": true, "eps": true, "pdfcairo": true,
+	"pngcairo": true, "cairolatex": true, "tikz": true, "webp": true}
+
+// transparentFormats are the formats whose terminal supports transparent backgrounds.
+var transparentFormats = map[string]bool{"png": true, "pngcairo": true}
+
+// SetTerminalOptions sets the options of the terminal used by SavePlot.
+//
+// Usage
+//  plot.SetFormat("cairolatex")
+//  plot.SetTerminalOptions(glot.TerminalOptions{Width: 5, Height: 3, Standalone: true})
+//  plot.SavePlot("figure.tex")
+func (plot *Plot) SetTerminalOptions(options TerminalOptions) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.terminal = options
+}
+
+// SetOutputSize sets the size in pixels of the images saved by SavePlot, in any format.
+// Vector formats are converted to inches with the DPI, 72 unless changed with SetDPI.
+//
+// Usage
+//  plot.SetFormat("pngcairo")
+//  plot.SetOutputSize(1600, 900)
+//  plot.SavePlot("1.png")
+func (plot *Plot) SetOutputSize(width, height int) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.terminal.Width, plot.terminal.Height = float64(width), float64(height)
+	if plot.terminal.DPI == 0 {
+		plot.terminal.DPI = 72
+	}
+}
+
+// SetDPI sets the resolution used to convert the size set by SetOutputSize to inches for vector formats.
+func (plot *Plot) SetDPI(dpi float64) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.terminal.DPI = dpi
+}
+
+// SetFontScale scales all text of the saved images, for the terminals that support it.
+func (plot *Plot) SetFontScale(scale float64) {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	plot.terminal.FontScale = scale
+}
+
+// terminalCommand returns the command selecting the terminal of a format with the options.
+func terminalCommand(format string, options TerminalOptions) string {
+	terminal, exists := terminals[format]
+	if !exists {
+		terminal = format
+	}
+	cmd := "set terminal " + terminal
+	if options.Width > 0 && options.Height > 0 {
+		switch {
+		case rasterFormats[format]:
+			cmd += fmt.Sprintf(" size %d,%d", int(options.Width), int(options.Height))
+		case options.DPI > 0:
+			cmd += fmt.Sprintf(" size %.2fin,%.2fin", options.Width/options.DPI, options.Height/options.DPI)
+		default:
+			cmd += fmt.Sprintf(" size %vin,%vin", options.Width, options.Height)
+		}
+	}
+	if options.Font != "" {
+		cmd += " font " + quote(options.Font)
+	}
+	if options.FontScale > 0 && fontScaleFormats[format] {
+		cmd += fmt.Sprintf(" fontscale %v", options.FontScale)
+	}
+	if strings.HasPrefix(terminal, "cairolatex") || terminal == "tikz" {
+		if options.Standalone {
+			cmd += " standalone"
+		} else if terminal == "tikz" {
+			cmd += " nostandalone"
+		} else {
+			cmd += " input"
+		}
+	}
+	if options.Transparent && transparentFormats[format] {
+		cmd += " transparent"
+	}
+	if options.Extra != "" {
+		cmd += " " + options.Extra
+	}
+	return cmd
+}
+
+// terminalCommand returns the command selecting the terminal the plot is saved with.
+func (plot *Plot) terminalCommand() string {
+	plot.mu.Lock()
+	defer plot.mu.Unlock()
+	return terminalCommand(plot.format, plot.terminal)
+}
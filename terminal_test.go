@@ -0,0 +1,56 @@
+package glot
+
+import "testing"
+
+func TestTerminalCommand(t *testing.T) {
+	cmd := terminalCommand("pdfcairo", TerminalOptions{Width: 720, Height: 360, DPI: 72, FontScale: 1.5})
+	if cmd != "set terminal pdfcairo size 10.00in,5.00in fontscale 1.5" {
+		t.Error("Unexpected terminal command ", cmd)
+	}
+}
+
+func TestTerminalCommandFormats(t *testing.T) {
+	tests := []struct {
+		format  string
+		options TerminalOptions
+		cmd     string
+	}{
+		{"svg", TerminalOptions{Width: 800, Height: 600, Font: "Helvetica,12"}, `set terminal svg size 800,600 font "Helvetica,12"`},
+		{"eps", TerminalOptions{Width: 5, Height: 3}, "set terminal epscairo size 5in,3in"},
+		{"cairolatex", TerminalOptions{Width: 5, Height: 3}, "set terminal cairolatex pdf size 5in,3in input"},
+		{"cairolatex", TerminalOptions{Standalone: true}, "set terminal cairolatex pdf standalone"},
+		{"tikz", TerminalOptions{}, "set terminal tikz nostandalone"},
+		{"tikz", TerminalOptions{Standalone: true, FontScale: 0.8}, "set terminal tikz fontscale 0.8 standalone"},
+		{"canvas", TerminalOptions{Width: 640, Height: 480, FontScale: 2}, "set terminal canvas size 640,480"},
+		{"webp", TerminalOptions{Width: 640, Height: 480, Extra: "animate delay 10"}, "set terminal webp size 640,480 animate delay 10"},
+		{"pngcairo", TerminalOptions{Transparent: true}, "set terminal pngcairo transparent"},
+		{"svg", TerminalOptions{Transparent: true}, "set terminal svg"},
+	}
+	for _, test := range tests {
+		if cmd := terminalCommand(test.format, test.options); cmd != test.cmd {
+			t.Errorf("terminalCommand(%s, %+v) = %q, expected %q", test.format, test.options, cmd, test.cmd)
+		}
+	}
+}
+
+func TestSetFormatTerminals(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	defer plot.Close()
+	for _, format := range []string{"svg", "eps", "pdfcairo", "pngcairo", "cairolatex", "tikz", "canvas", "webp"} {
+		if err := plot.SetFormat(format); err != nil {
+			t.Errorf("SetFormat(%s) = %v", format, err)
+		}
+	}
+	if err := plot.SetFormat("bmp"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+	plot.SetFormat("svg")
+	plot.SetOutputSize(1600, 900)
+	if cmd := plot.terminalCommand(); cmd != "set terminal svg size 1600,900" {
+		t.Error("Unexpected terminal command ", cmd)
+	}
+	plot.SetFormat("pdfcairo")
+	if cmd := plot.terminalCommand(); cmd != "set terminal pdfcairo size 22.22in,12.50in" {
+		t.Error("Unexpected terminal command ", cmd)
+	}
+}
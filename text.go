@@ -0,0 +1,77 @@
+package glot
+
+// TextBuilder builds a text in gnuplot's enhanced text markup, for titles, labels
+// and PointGroup names with subscripts, superscripts and Greek letters.
+// The markup is only read as such while literal text is off, see SetLiteralText.
+//
+// Usage
+//  plot.SetYLabel(glot.Text().Greek("sigma").Super("2").String())                 // σ²
+//  plot.SetXLabel(glot.Text().Plain("t / 10").Super("-3").Plain(" s").String())   // t / 10⁻³ s
+//  plot.AddPointGroup(glot.Text().Plain("x").Sub("n+1").String(), "lines", data) // xₙ₊₁
+type TextBuilder struct {
+	markup string
+}
+
+// Text starts a new text.
+func Text() *TextBuilder {
+	return &TextBuilder{}
+}
+
+// Plain appends text that is shown as it is written.
+func (text *TextBuilder) Plain(s string) *TextBuilder {
+	text.markup += enhancedEscaper.Replace(s)
+	return text
+}
+
+// Sub appends a subscript.
+func (text *TextBuilder) Sub(s string) *TextBuilder {
+	text.markup += "_{" + enhancedEscaper.Replace(s) + "}"
+	return text
+}
+
+// Super appends a superscript.
+func (text *TextBuilder) Super(s string) *TextBuilder {
+	text.markup += "^{" + enhancedEscaper.Replace(s) + "}"
+	return text
+}
+
+// Greek appends the Greek letter with the given name, like "alpha" for α or "Omega" for Ω.
+// A name that is not a Greek letter is appended as it is.
+func (text *TextBuilder) Greek(name string) *TextBuilder {
+	if letter, exists := greekLetters[name]; exists {
+		text.markup += letter
+		return text
+	}
+	return text.Plain(name)
+}
+
+// Bold appends bold text, for the terminals with bold fonts.
+func (text *TextBuilder) Bold(s string) *TextBuilder {
+	text.markup += "{/:Bold " + enhancedEscaper.Replace(s) + "}"
+	return text
+}
+
+// Italic appends italic text, for the terminals with italic fonts.
+func (text *TextBuilder) Italic(s string) *TextBuilder {
+	text.markup += "{/:Italic " + enhancedEscaper.Replace(s) + "}"
+	return text
+}
+
+// String returns the text in gnuplot's enhanced text markup.
+func (text *TextBuilder) String() string {
+	return text.markup
+}
+
+// greekLetters maps the names of the Greek letters to the letters.
+// The letters are written in UTF-8, which the terminals of gnuplot 5 support,
+// instead of the Symbol font of older versions.
+var greekLetters = map[string]string{
+	"alpha": "α", "beta": "β", "gamma": "γ", "delta": "δ", "epsilon": "ε", "zeta": "ζ",
+	"eta": "η", "theta": "θ", "iota": "ι", "kappa": "κ", "lambda": "λ", "mu": "μ",
+	"nu": "ν", "xi": "ξ", "omicron": "ο", "pi": "π", "rho": "ρ", "sigma": "σ",
+	"tau": "τ", "upsilon": "υ", "phi": "φ", "chi": "χ", "psi": "ψ", "omega": "ω",
+	"Alpha": "Α", "Beta": "Β", "Gamma": "Γ", "Delta": "Δ", "Epsilon": "Ε", "Zeta": "Ζ",
+	"Eta": "Η", "Theta": "Θ", "Iota": "Ι", "Kappa": "Κ", "Lambda": "Λ", "Mu": "Μ",
+	"Nu": "Ν", "Xi": "Ξ", "Omicron": "Ο", "Pi": "Π", "Rho": "Ρ", "Sigma": "Σ",
+	"Tau": "Τ", "Upsilon": "Υ", "Phi": "Φ", "Chi": "Χ", "Psi": "Ψ", "Omega": "Ω",
+}
@@ -0,0 +1,14 @@
+package glot
+
+import (
+	"testing"
+)
+
+func TestTextBuilder(t *testing.T) {
+	if s := Text().Greek("sigma").Super("2").String(); s != "σ^{2}" {
+		t.Error("Unexpected markup ", s)
+	}
+	if s := Text().Plain("file_1").Sub("n").Greek("nope").String(); s != `file\_1_{n}nope` {
+		t.Error("Unexpected markup ", s)
+	}
+}
@@ -0,0 +1,130 @@
+package glot
+
+import "fmt"
+
+// Theme holds the look of a plot: its colors, font and border, see ApplyTheme.
+type Theme struct {
+	Background string   // Color of the background, the terminal's default (white) when empty
+	Foreground string   // Color of the border, tics and text, black when empty
+	GridColor  string   // Color of the grid lines, no grid when empty
+	Font       string   // Font of all text, like "Helvetica,12", the terminal's default when empty
+	Colors     []string // Colors given in turn to the curves without a color, see SetColorCycle
+	Border     int      // Sides of the border as the sum of 1 bottom, 2 left, 4 top and 8 right, all when 0
+}
+
+// The built-in themes.
+var (
+	// ThemeDefault is the look of a new plot, it undoes other themes.
+	ThemeDefault = Theme{Colors: ColorCycleTableau10}
+	// ThemeDark draws light text and bright curves on a dark background.
+	ThemeDark = Theme{
+		Background: "#1e1e1e",
+		Foreground: "#d4d4d4",
+		GridColor:  "#3c3c3c",
+		Colors:     []string{"#4fc3f7", "#ffb74d", "#81c784", "#e57373", "#ba68c8", "#fff176", "#a1887f", "#f06292"},
+	}
+	// ThemeMinimal has a light grid and only the left and bottom border.
+	ThemeMinimal = Theme{
+		Foreground: "#555555",
+		GridColor:  "#e5e5e5",
+		Colors:     ColorCycleTableau10,
+		Border:     3,
+	}
+	// ThemePublication uses a serif font and colors that stay distinguishable for color blind readers.
+	ThemePublication = Theme{
+		Foreground: "black",
+		Font:       "Times,10",
+		Colors:     []string{"#000000", "#e69f00", "#56b4e9", "#009e73", "#0072b2", "#d55e00", "#cc79a7", "#f0e442"},
+		Border:     3,
+	}
+)
+
+// ApplyTheme gives the plot the look of the theme and draws it again.
+// The theme replaces the colors, grid, border and terminal font set before,
+// the font is used when the plot is saved.
+//
+// Usage
+//  plot.ApplyTheme(glot.ThemeDark)
+//  plot.AddPointGroup("Sample1", "lines", []int32{51, 8, 4, 11})
+//  plot.SavePlot("dark.png")
+func (plot *Plot) ApplyTheme(theme Theme) error {
+	foreground := theme.Foreground
+	if foreground == "" {
+		foreground = "black"
+	}
+	border := theme.Border
+	if border == 0 {
+		border = 31
+	}
+	var cmds []setting
+	add := func(key, format string, a ...interface{}) {
+		cmds = append(cmds, setting{key: key, cmd: fmt.Sprintf(format, a...)})
+	}
+
+	plot.mu.Lock()
+	plot.terminal.Font = theme.Font
+	plot.colors = append([]string{}, theme.Colors...)
+	if cmd := plot.backgroundCmd(theme.Background); cmd != "" {
+		add("background", "%s", cmd)
+	}
+	plot.mu.Unlock()
+
+	add("border", "set border %d lc rgb %s", border, quote(foreground))
+	add("tics textcolor", "set tics textcolor rgb %s", quote(foreground))
+	add("key textcolor", "set key textcolor rgb %s", quote(foreground))
+	for _, label := range []string{"title", "xlabel", "ylabel", "zlabel"} {
+		add(label+" textcolor", "set %s textcolor rgb %s", label, quote(foreground))
+	}
+	if theme.GridColor != "" {
+		add("grid", "set grid back lc rgb %s", quote(theme.GridColor))
+	} else {
+		add("grid", "unset grid")
+	}
+	for _, cmd := range cmds {
+		err := plot.set(cmd.key, "%s", cmd.cmd)
+		if err != nil {
+			return err
+		}
+	}
+	return plot.redraw()
+}
+
+// SetBackground fills the background of the image with the color, or removes the fill
+// when the color is empty. With transparent, the png and pngcairo images are saved with a
+// transparent background, so that charts can be put on dark dashboards and slides.
+// Use an empty color for a fully transparent background, or a color with an alpha
+// channel like "#80000000" for a translucent one.
+//
+// Usage
+//  plot.SetFormat("pngcairo")
+//  plot.SetBackground("", true)
+//  plot.SavePlot("overlay.png")
+func (plot *Plot) SetBackground(color string, transparent bool) error {
+	plot.mu.Lock()
+	plot.terminal.Transparent = transparent
+	cmd := plot.backgroundCmd(color)
+	plot.mu.Unlock()
+	if cmd == "" {
+		return nil
+	}
+	err := plot.set("background", "%s", cmd)
+	if err != nil {
+		return err
+	}
+	return plot.redraw()
+}
+
+// backgroundCmd returns the command filling the background with the color, or removing the
+// fill when the color is empty. It is empty when there is no fill to remove. The caller holds mu.
+func (plot *Plot) backgroundCmd(color string) string {
+	if color == "" {
+		if plot.background == 0 {
+			return ""
+		}
+		return fmt.Sprintf("unset object %d", plot.background)
+	}
+	if plot.background == 0 {
+		plot.background = plot.nextTag()
+	}
+	return fmt.Sprintf("set object %d rect from screen 0,0 to screen 1,1 behind fc rgb %s fs solid noborder", plot.background, quote(color))
+}
@@ -0,0 +1,41 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyTheme(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.AddPointGroup("Sample1", "lines", []float64{1, 2, 3})
+	if err := plot.ApplyTheme(ThemeDark); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(plot.PointGroup["Sample1"].appearance(), "lc rgb \"#4fc3f7\"") {
+		t.Error("Expected the first color of the theme, got ", plot.PointGroup["Sample1"].appearance())
+	}
+	template := plot.Template()
+	other, _ := template.NewPlot(2, false, false)
+	defer other.Close()
+	if other.background == 0 || other.options[0].cmd != "set object 1 rect from screen 0,0 to screen 1,1 behind fc rgb \"#1e1e1e\" fs solid noborder" {
+		t.Error("Expected the background of the template, got ", other.options)
+	}
+	plot.ApplyTheme(ThemeDefault)
+	if !strings.Contains(plot.PointGroup["Sample1"].appearance(), "lc rgb \"#4e79a7\"") || plot.options[0].cmd != "unset object 1" {
+		t.Error("Expected the default theme to undo the dark theme")
+	}
+}
+
+func TestSetBackground(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetFormat("pngcairo")
+	plot.SetBackground("#202020", true)
+	if cmd := plot.terminalCommand(); cmd != "set terminal pngcairo transparent" {
+		t.Error("Unexpected terminal command ", cmd)
+	}
+	if !strings.Contains(plot.options[0].cmd, "fc rgb \"#202020\"") {
+		t.Error("Expected the background to be filled, got ", plot.options)
+	}
+}
@@ -0,0 +1,60 @@
+package glot
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimeSeriesData(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	start := time.Date(2020, 3, 1, 12, 30, 0, 0, time.UTC)
+	err = plot.AddPointGroup("Load", "lines", TimeSeriesData{
+		Time:   []time.Time{start, start.Add(time.Hour)},
+		Y:      []float64{0.5, 0.75},
+		Format: "%H:%M",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(plot.DataFiles()["Load"])
+	if err != nil || string(data) != "2020-03-01T12:30:00 0.5\n2020-03-01T13:30:00 0.75\n" {
+		t.Errorf("Unexpected data file %q, %v", data, err)
+	}
+	script := plot.DumpScript()
+	for _, expected := range []string{"set xdata time", `set timefmt "%Y-%m-%dT%H:%M:%S"`, `set format x "%H:%M"`, ` using 1:2 title "Load" with lines`} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("Expected %q in the script:\n%s", expected, script)
+		}
+	}
+}
+
+func TestTimeSeriesDataUnix(t *testing.T) {
+	plot, err := NewPlotWithOptions(WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	err = plot.AddPointGroup("Load", "lines", TimeSeriesData{Unix: []int64{0, 60}, Y: []float64{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(plot.DataFiles()["Load"])
+	expected := time.Unix(0, 0).Format(timeLayout) + " 1\n" + time.Unix(60, 0).Format(timeLayout) + " 2\n"
+	if err != nil || string(data) != expected {
+		t.Errorf("Expected the data file %q, got %q, %v", expected, data, err)
+	}
+	if script := plot.DumpScript(); !strings.Contains(script, `set format x "%Y-%m-%d %H:%M"`) {
+		t.Errorf("Expected the default time format in the script:\n%s", script)
+	}
+
+	err = plot.AddPointGroup("Short", "lines", TimeSeriesData{Unix: []int64{0}, Y: []float64{1, 2}})
+	if err == nil {
+		t.Error("Expected an error for times and values of different lengths")
+	}
+}
@@ -0,0 +1,98 @@
+package glot
+
+import "fmt"
+
+// VectorData holds the arrows of a vector field. Each arrow starts at (X, Y, Z)
+// and points to (X+DX, Y+DY, Z+DZ). Z and DZ are only used by 3-d plots.
+type VectorData struct {
+	X, Y, Z    []float64
+	DX, DY, DZ []float64
+	Scale      float64 // Multiplies the length of the arrows, 1 when 0
+	Head       string  // Arrowhead, one of head (default), heads, backhead or nohead
+	Filled     bool    // Fill the arrowheads
+}
+
+// AddVectorField adds a 2-d vector field, like a flow field or a gradient,
+// with an arrow from (x[i], y[i]) to (x[i]+dx[i], y[i]+dy[i]).
+// Use AddPointGroup with VectorData and the style "vectors" for the arrowhead and scaling options.
+//
+// Usage
+//  dimensions := 2
+//  persist := false
+//  debug := false
+//  plot, _ := glot.NewPlot(dimensions, persist, debug)
+//  plot.AddVectorField("Wind", []float64{0, 1}, []float64{0, 0}, []float64{0.5, 0.2}, []float64{0.1, 0.4})
+//  plot.AddPointGroup("Gradient", "vectors", glot.VectorData{X: x, Y: y, DX: dx, DY: dy, Scale: 0.1, Filled: true})
+func (plot *Plot) AddVectorField(name string, x, y, dx, dy []float64) error {
+	return plot.AddPointGroup(name, "vectors", VectorData{X: x, Y: y, DX: dx, DY: dy})
+}
+
+// AddVectorField3d adds a 3-d vector field
+// with an arrow from (x[i], y[i], z[i]) to (x[i]+dx[i], y[i]+dy[i], z[i]+dz[i]).
+func (plot *Plot) AddVectorField3d(name string, x, y, z, dx, dy, dz []float64) error {
+	return plot.AddPointGroup(name, "vectors", VectorData{X: x, Y: y, Z: z, DX: dx, DY: dy, DZ: dz})
+}
+
+func (plot *Plot) plotVectors(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(VectorData)
+	scale := data.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	columns := [][]float64{data.X, data.Y}
+	deltas := [][]float64{data.DX, data.DY}
+	if plot.dimensions == 3 {
+		columns = append(columns, data.Z)
+		deltas = append(deltas, data.DZ)
+	}
+	for _, delta := range deltas {
+		scaled := make([]float64, len(delta))
+		for i, d := range delta {
+			scaled[i] = d * scale
+		}
+		columns = append(columns, scaled)
+	}
+	for _, column := range columns {
+		if len(column) != len(data.X) {
+			return &gnuplotError{err: fmt.Sprintf("The vector field arrays of %s don't have the same length.", pointGroup.name)}
+		}
+	}
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+	err = writeText(f, columns...)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	cmd := plot.plotcmd
+	using := "1:2:3:4"
+	if plot.dimensions == 3 {
+		cmd = "splot"
+		using = "1:2:3:4:5:6"
+	}
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	head := data.Head
+	if head == "" {
+		head = "head"
+	}
+	if data.Filled {
+		head += " filled"
+	}
+	pointGroup.style = "vectors"
+
+	var line string
+	if pointGroup.name == "" {
+		line = fmt.Sprintf("%s %s using %s with vectors %s", cmd, quote(fname), using, head)
+	} else {
+		line = fmt.Sprintf("%s %s using %s title %s with vectors %s",
+			cmd, quote(fname), using, plot.text(pointGroup.name), head)
+	}
+	return plot.sendPlotLine(pointGroup, line)
+}
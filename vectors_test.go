@@ -0,0 +1,18 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddVectorField(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	err := plot.AddVectorField("Wind", []float64{0, 1}, []float64{0, 0}, []float64{0.5, 0.2}, []float64{0.1})
+	if err == nil {
+		t.Error("Expected an error when the arrays have different lengths.")
+	}
+	err = plot.AddPointGroup("Flow", "vectors", VectorData{X: []float64{0}, Y: []float64{0}, DX: []float64{1}, DY: []float64{1}, Filled: true})
+	if err != nil || !strings.Contains(strings.Join(plot.PointGroup["Flow"].specs, ", "), "using 1:2:3:4 title \"Flow\" with vectors head filled") {
+		t.Error("Unexpected vector field ", err)
+	}
+}
@@ -0,0 +1,121 @@
+package glot
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// GnuplotVersion is the version of gnuplot, like 5.4 patchlevel 2.
+type GnuplotVersion struct {
+	Major, Minor, Patch int
+}
+
+func (v GnuplotVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// AtLeast reports whether the version is major.minor or newer.
+func (v GnuplotVersion) AtLeast(major, minor int) bool {
+	return v.Major > major || v.Major == major && v.Minor >= minor
+}
+
+// features are the gnuplot versions that introduced the features glot gates on.
+var features = map[string]GnuplotVersion{
+	"pngcairo":     {Major: 4, Minor: 4},
+	"dashtype":     {Major: 5, Minor: 0},
+	"rgbalpha":     {Major: 5, Minor: 0},
+	"parallelaxes": {Major: 5, Minor: 2},
+	"datablock":    {Major: 5, Minor: 0},
+	"fit":          {Major: 5, Minor: 0}, // set fit quiet nolog
+	"stats":        {Major: 4, Minor: 6},
+	// gnuplot -d, which skips the initialization files.
+	"default-settings": {Major: 5, Minor: 0},
+}
+
+// versionCache holds the versions of the gnuplot binaries by their paths.
+var versionCache struct {
+	sync.Mutex
+	versions map[string]GnuplotVersion
+}
+
+// Version returns the version of gnuplot, from the output of gnuplot --version.
+// It is run once per gnuplot binary, see SetGnuplotPath.
+//
+// Usage
+//  version, err := glot.Version()
+//  if err == nil && !version.AtLeast(5, 0) {
+//  	log.Println("gnuplot", version, "is too old for dashed lines")
+//  }
+func Version() (GnuplotVersion, error) {
+	return versionOf(gGnuplotCmd)
+}
+
+// versionOf returns the version of the gnuplot binary, run once per binary.
+func versionOf(gnuplot string) (GnuplotVersion, error) {
+	if gnuplot == "" {
+		return GnuplotVersion{}, &gnuplotError{err: "could not find path to 'gnuplot'", kind: ErrGnuplotNotFound}
+	}
+	versionCache.Lock()
+	defer versionCache.Unlock()
+	if version, ok := versionCache.versions[gnuplot]; ok {
+		return version, nil
+	}
+	out, err := exec.Command(gnuplot, "--version").Output()
+	if err != nil {
+		return GnuplotVersion{}, &gnuplotError{err: fmt.Sprintf("could not run %s: %v", gnuplot, err), kind: ErrGnuplotNotFound}
+	}
+	version, err := parseVersion(strings.TrimSpace(string(out)))
+	if err != nil {
+		return GnuplotVersion{}, err
+	}
+	if versionCache.versions == nil {
+		versionCache.versions = make(map[string]GnuplotVersion)
+	}
+	versionCache.versions[gnuplot] = version
+	return version, nil
+}
+
+// parseVersion reads the output of gnuplot --version, like "gnuplot 5.4 patchlevel 2".
+func parseVersion(out string) (GnuplotVersion, error) {
+	var version GnuplotVersion
+	fields := strings.Fields(out)
+	if len(fields) < 2 || fields[0] != "gnuplot" {
+		return version, &gnuplotError{err: fmt.Sprintf("could not read the gnuplot version from %q", out)}
+	}
+	parts := strings.SplitN(fields[1], ".", 2)
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts) < 2 {
+		return version, &gnuplotError{err: fmt.Sprintf("could not read the gnuplot version from %q", out)}
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return version, &gnuplotError{err: fmt.Sprintf("could not read the gnuplot version from %q", out)}
+	}
+	version.Major, version.Minor = major, minor
+	if len(fields) >= 4 && fields[2] == "patchlevel" {
+		// Release candidates have patchlevels like rc1, left at 0.
+		version.Patch, _ = strconv.Atoi(fields[3])
+	}
+	return version, nil
+}
+
+// requireFeature returns an error of kind ErrUnsupportedFeature when the gnuplot binary
+// of the plot is older than the version that introduced the feature. When the version
+// can't be detected the feature is allowed, gnuplot reports the failure itself then.
+func (plot *Plot) requireFeature(feature string) error {
+	needed, ok := features[feature]
+	if !ok {
+		return nil
+	}
+	version, err := versionOf(plot.gnuplot)
+	if err != nil || version.AtLeast(needed.Major, needed.Minor) {
+		return nil
+	}
+	return &gnuplotError{
+		err:  fmt.Sprintf("%s requires gnuplot >= %d.%d, found %d.%d", feature, needed.Major, needed.Minor, version.Major, version.Minor),
+		kind: ErrUnsupportedFeature,
+	}
+}
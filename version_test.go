@@ -0,0 +1,55 @@
+package glot
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	version, err := parseVersion("gnuplot 5.4 patchlevel 2\n")
+	if err != nil || version != (GnuplotVersion{5, 4, 2}) {
+		t.Fatalf("parseVersion = %v, %v", version, err)
+	}
+	if !version.AtLeast(5, 0) || !version.AtLeast(4, 6) || version.AtLeast(5, 5) || version.AtLeast(6, 0) {
+		t.Errorf("AtLeast of %v is wrong", version)
+	}
+	if _, err := parseVersion("Version 3"); err == nil {
+		t.Error("parseVersion accepted a version without gnuplot")
+	}
+}
+
+func TestRequireFeature(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a shell script as gnuplot")
+	}
+	dir, err := ioutil.TempDir("", "glot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	gnuplot := filepath.Join(dir, "gnuplot")
+	err = ioutil.WriteFile(gnuplot, []byte("#!/bin/sh\necho gnuplot 4.6 patchlevel 0\n"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plot, err := NewPlotWithOptions(WithDryRun(), WithGnuplotPath(gnuplot))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plot.Close()
+	err = plot.requireFeature("dashtype")
+	if !errors.Is(err, ErrUnsupportedFeature) || err.Error() != "dashtype requires gnuplot >= 5.0, found 4.6" {
+		t.Errorf("requireFeature(dashtype) = %v", err)
+	}
+	if err := plot.requireFeature("pngcairo"); err != nil {
+		t.Errorf("requireFeature(pngcairo) = %v", err)
+	}
+	err = plot.SetInlineData(true)
+	if !errors.Is(err, ErrUnsupportedFeature) {
+		t.Errorf("SetInlineData with gnuplot 4.6 = %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package glot
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// videoCodecs are the ffmpeg options encoding the videos of the supported containers.
+var videoCodecs = map[string][]string{
+	// yuv420p is the pixel format all players support, and it needs even sizes.
+	".mp4":  {"-c:v", "libx264", "-pix_fmt", "yuv420p", "-vf", "scale=trunc(iw/2)*2:trunc(ih/2)*2"},
+	".webm": {"-c:v", "libvpx-vp9", "-pix_fmt", "yuv420p", "-b:v", "0", "-crf", "32"},
+}
+
+// ExportVideo draws the frames of the animation to PNG images in a temporary directory
+// and encodes them with ffmpeg, which must be installed, as a video with fps frames per second.
+// The extension of path selects the format, .mp4 or .webm. The delay and loop count
+// of the animation options don't apply to videos.
+//
+// Usage
+//  anim.ExportVideo("simulation.mp4", 30)
+func (anim *Animation) ExportVideo(path string, fps int) error {
+	args, err := videoArgs(path, fps)
+	if err != nil {
+		return err
+	}
+	ffmpeg, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return &gnuplotError{err: fmt.Sprintf("could not find path to 'ffmpeg': %v", err), kind: ErrFFmpegNotFound}
+	}
+	ctx := context.Background()
+	plots, err := anim.render(ctx)
+	defer closePlots(plots)
+	if err != nil {
+		return err
+	}
+	dir, err := ioutil.TempDir("", gGnuplotPrefix)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	options := TerminalOptions{Width: float64(anim.options.Width), Height: float64(anim.options.Height)}
+	commands := []string{terminalCommand("pngcairo", options)}
+	for i, plot := range plots {
+		commands = append(commands, "set output "+quote(filepath.Join(dir, fmt.Sprintf("frame%05d.png", i))))
+		commands = append(commands, frameCommands(plot)...)
+	}
+	commands = append(commands, "unset output")
+	err = runScript(ctx, commands, nil, debugLogger(anim.options.Debug))
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, ffmpeg, append([]string{"-y", "-loglevel", "error",
+		"-framerate", fmt.Sprint(fps), "-i", filepath.Join(dir, "frame%05d.png")}, args...)...)
+	if logger := debugLogger(anim.options.Debug); logger != nil {
+		logger.Log(LogDebug, "command", "cmd", strings.Join(cmd.Args, " "))
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return &gnuplotError{err: fmt.Sprintf("ffmpeg failed: %v: %s", err, strings.TrimSpace(string(out)))}
+	}
+	return nil
+}
+
+// videoArgs returns the ffmpeg options writing a video to path.
+func videoArgs(path string, fps int) ([]string, error) {
+	if fps < 1 {
+		return nil, &gnuplotError{err: fmt.Sprintf("The frame rate %d is not positive.", fps)}
+	}
+	codec, exists := videoCodecs[strings.ToLower(filepath.Ext(path))]
+	if !exists {
+		return nil, &gnuplotError{err: fmt.Sprintf("The video format of %s is not supported, use .mp4 or .webm.", path)}
+	}
+	return append(append([]string{}, codec...), path), nil
+}
@@ -0,0 +1,16 @@
+package glot
+
+import "testing"
+
+func TestVideoArgs(t *testing.T) {
+	args, err := videoArgs("out.MP4", 30)
+	if err != nil || args[1] != "libx264" || args[len(args)-1] != "out.MP4" {
+		t.Error("Unexpected ffmpeg options ", args, err)
+	}
+	if _, err := videoArgs("out.avi", 30); err == nil {
+		t.Error("Expected an error for an unsupported format.")
+	}
+	if _, err := videoArgs("out.webm", 0); err == nil {
+		t.Error("Expected an error for a frame rate of 0.")
+	}
+}
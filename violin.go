@@ -0,0 +1,189 @@
+package glot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ViolinData holds groups of samples that are drawn as violins side by side,
+// the density of every group estimated in Go with a gaussian kernel and mirrored
+// around the position of the group. The violins are scaled alike, so that
+// their areas are the same.
+//
+// Usage
+//  plot.AddPointGroup("Accuracy", "filledcurves", glot.ViolinData{
+//  	Groups:  [][]float64{baseline, dropout, augmented},
+//  	Labels:  []string{"baseline", "dropout", "augmented"},
+//  	BoxPlot: true,
+//  })
+type ViolinData struct {
+	Groups    [][]float64
+	Labels    []string // x-axis tic label of every group
+	Bandwidth float64  // Bandwidth of the kernel, Silverman's rule of thumb for every group when 0
+	Width     float64  // Width of the widest violin, 0.8 when 0
+	Points    int      // Number of points the density is estimated at, 100 when 0
+	BoxPlot   bool     // Draw a narrow boxplot inside every violin
+}
+
+// violinFill is the fill style of violins, light so that the boxplots in front of them stand out.
+const violinFill = "transparent solid 0.5"
+
+func (plot *Plot) plotViolin(pointGroup *PointGroup) error {
+	data := pointGroup.castedData.(ViolinData)
+	if len(data.Groups) == 0 {
+		return &gnuplotError{err: fmt.Sprintf("The violin plot %s has no groups.", pointGroup.name), kind: ErrLengthMismatch}
+	}
+	width := data.Width
+	if width <= 0 {
+		width = 0.8
+	}
+	points := data.Points
+	if points < 2 {
+		points = 100
+	}
+
+	ys := make([][]float64, len(data.Groups))
+	densities := make([][]float64, len(data.Groups))
+	peak := 0.0
+	for i, group := range data.Groups {
+		if len(group) == 0 {
+			return &gnuplotError{err: fmt.Sprintf("The group %d of the violin plot %s has no samples.", i, pointGroup.name), kind: ErrLengthMismatch}
+		}
+		ys[i], densities[i] = kernelDensity(group, data.Bandwidth, points)
+		for _, d := range densities[i] {
+			peak = math.Max(peak, d)
+		}
+	}
+	scale := 0.0
+	if peak > 0 {
+		scale = width / 2 / peak
+	}
+
+	f, err := plot.dataFile(pointGroup)
+	if err != nil {
+		return err
+	}
+	fname := f.Name()
+	// The first data block has the outlines of the violins, separated by blank lines:
+	// up the left side and down the right side. The samples of the boxplots follow.
+	for i := range data.Groups {
+		if i > 0 {
+			f.WriteString("\n")
+		}
+		x := float64(i + 1)
+		for j, y := range ys[i] {
+			f.WriteString(fmt.Sprintf("%v %v\n", x-densities[i][j]*scale, y))
+		}
+		for j := len(ys[i]) - 1; j >= 0; j-- {
+			f.WriteString(fmt.Sprintf("%v %v\n", x+densities[i][j]*scale, ys[i][j]))
+		}
+	}
+	if data.BoxPlot {
+		f.WriteString("\n\n")
+		for i, group := range data.Groups {
+			for _, v := range group {
+				f.WriteString(fmt.Sprintf("%d %v\n", i+1, v))
+			}
+		}
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	if data.Labels != nil {
+		tics := make([]string, len(data.Labels))
+		for i, label := range data.Labels {
+			tics[i] = fmt.Sprintf("%s %d", plot.text(label), i+1)
+		}
+		cmd := fmt.Sprintf("set xtics (%s)", strings.Join(tics, ", "))
+		plot.keepSetting("xtics", cmd)
+		err = plot.Cmd("%s", cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd := plot.plotcmd
+	if plot.nplots > 0 {
+		cmd = plotCommand
+	}
+	if pointGroup.fillStyle == "" {
+		pointGroup.fillStyle = violinFill
+	}
+	pointGroup.style = "filledcurves"
+
+	title := "notitle"
+	if pointGroup.name != "" {
+		title = fmt.Sprintf("title %s", plot.text(pointGroup.name))
+	}
+	specs := []string{fmt.Sprintf("%s index 0 using 1:2 %s with filledcurves closed", quote(fname), title)}
+	if data.BoxPlot {
+		// The group in the first column is the factor of the boxplots, placed at x = 1, 2, ...
+		plot.keepSetting("style boxplot", "set style boxplot nooutliers labels off")
+		err = plot.Cmd("set style boxplot nooutliers labels off")
+		if err != nil {
+			return err
+		}
+		specs = append(specs, fmt.Sprintf("%s index 1 using (1):2:(%v):1 notitle with boxplot lc rgb \"black\" fs solid 1.0", quote(fname), width/8))
+	}
+	return plot.sendPlotSpecs(pointGroup, cmd, specs...)
+}
+
+// kernelDensity returns the density of the values estimated with a gaussian kernel
+// at points evenly spaced from the smallest to the largest value.
+// A bandwidth of 0 is chosen by Silverman's rule of thumb.
+func kernelDensity(values []float64, bandwidth float64, points int) ([]float64, []float64) {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	n := float64(len(sorted))
+	if bandwidth <= 0 {
+		mean := 0.0
+		for _, v := range sorted {
+			mean += v
+		}
+		mean /= n
+		variance := 0.0
+		for _, v := range sorted {
+			variance += (v - mean) * (v - mean)
+		}
+		spread := math.Sqrt(variance / math.Max(n-1, 1))
+		if iqr := (quantile(sorted, 0.75) - quantile(sorted, 0.25)) / 1.34; iqr > 0 && iqr < spread {
+			spread = iqr
+		}
+		bandwidth = 0.9 * spread * math.Pow(n, -0.2)
+	}
+	low, high := sorted[0], sorted[len(sorted)-1]
+	if bandwidth <= 0 {
+		// All values are the same.
+		bandwidth = 0.5
+	}
+	if low == high {
+		low, high = low-bandwidth, high+bandwidth
+	}
+
+	y := make([]float64, points)
+	density := make([]float64, points)
+	norm := 1 / (n * bandwidth * math.Sqrt(2*math.Pi))
+	for i := range y {
+		y[i] = low + (high-low)*float64(i)/float64(points-1)
+		for _, v := range sorted {
+			u := (y[i] - v) / bandwidth
+			density[i] += math.Exp(-u * u / 2)
+		}
+		density[i] *= norm
+	}
+	return y, density
+}
+
+// quantile returns the q-quantile of the sorted values, interpolated linearly.
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	i := int(pos)
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (pos-float64(i))*(sorted[i+1]-sorted[i])
+}
@@ -0,0 +1,25 @@
+package glot
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestViolinData(t *testing.T) {
+	plot, _ := NewPlotWithOptions(WithDryRun())
+	data := ViolinData{Groups: [][]float64{{1, 2, 2, 3}, {5, 6, 7}}, Labels: []string{"a", "b"}, BoxPlot: true}
+	err := plot.AddPointGroup("Scores", "filledcurves", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := plot.PointGroup["Scores"].plotSpec()
+	if !strings.Contains(spec, "fs transparent solid 0.5, ") || !strings.Contains(spec, "with boxplot lc rgb \"black\" fs solid 1.0") {
+		t.Error("Expected the boxplots and the violins, got ", spec)
+	}
+
+	y, density := kernelDensity([]float64{0, 0, 0}, 1, 3)
+	if y[0] != -1 || y[2] != 1 || math.Abs(density[1]-1/math.Sqrt(2*math.Pi)) > 1e-12 {
+		t.Error("Expected the standard normal density, got ", y, density)
+	}
+}
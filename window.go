@@ -0,0 +1,89 @@
+package glot
+
+// SetWindowTitle sets the title of the window the plot is shown in.
+// The interactive terminal is selected first when none was, see SetInteractiveTerminal.
+//
+// Usage
+//  plot, _ := glot.NewPlot(2, true, false)
+//  plot.SetWindowTitle("Training loss")
+func (plot *Plot) SetWindowTitle(title string) error {
+	return plot.setWindow(func() { plot.winTitle = title })
+}
+
+// SetPersist sets whether the window of the plot stays open after the plot is closed,
+// overriding the persist argument of NewPlot, for the qt, wxt and x11 terminals.
+func (plot *Plot) SetPersist(persist bool) error {
+	return plot.setWindow(func() {
+		plot.persist = "nopersist"
+		if persist {
+			plot.persist = "persist"
+		}
+	})
+}
+
+// Show opens the window of the plot when it was hidden, draws the plot
+// and raises the window above the other windows.
+//
+// Usage
+//  plot.Hide()
+//  plot.AddPointGroup("Sample 2", "points", []float64{1, 4, 2, 3})
+//  plot.Show()
+func (plot *Plot) Show() error {
+	err := plot.setWindow(func() {})
+	if err != nil {
+		return err
+	}
+	err = plot.redraw()
+	if err != nil {
+		return err
+	}
+	return plot.Cmd("raise")
+}
+
+// Hide closes the window of the plot. The plot keeps its PointGroups and settings,
+// and Show opens the window again.
+func (plot *Plot) Hide() error {
+	plot.mu.Lock()
+	screen := plot.screen
+	plot.mu.Unlock()
+	if screen == "" || screen == "unknown" {
+		return nil
+	}
+	return plot.Cmd("set terminal %s close", screen)
+}
+
+// setWindow changes the options of the window with change, called with mu held,
+// and selects the interactive terminal with the new options.
+func (plot *Plot) setWindow(change func()) error {
+	plot.mu.Lock()
+	screen := plot.screen
+	plot.mu.Unlock()
+	if screen == "" {
+		err := plot.SetInteractiveTerminal("auto")
+		if err != nil {
+			return err
+		}
+	}
+	plot.mu.Lock()
+	if plot.screen == "" || plot.screen == "unknown" {
+		plot.mu.Unlock()
+		return &gnuplotError{err: "The plot has no window, gnuplot has no interactive terminal or the plot is headless.", kind: ErrUnknownTerminal}
+	}
+	change()
+	cmd := plot.screenCmd()
+	plot.mu.Unlock()
+	return plot.Cmd("%s", cmd)
+}
+
+// screenCmd returns the command selecting the interactive terminal with the options of the window.
+// The caller must hold mu.
+func (plot *Plot) screenCmd() string {
+	cmd := "set terminal " + plot.screen
+	if plot.winTitle != "" {
+		cmd += " title " + quote(plot.winTitle)
+	}
+	if plot.persist != "" {
+		cmd += " " + plot.persist
+	}
+	return cmd
+}
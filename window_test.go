@@ -0,0 +1,26 @@
+package glot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWindow(t *testing.T) {
+	plot, _ := NewPlot(2, false, false)
+	defer plot.Close()
+	plot.SetHeadless()
+	if plot.SetWindowTitle("Loss") == nil {
+		t.Error("Expected an error for the window title of a headless plot.")
+	}
+	plot.screen = "qt"
+	plot.SetWindowTitle("Loss")
+	plot.SetPersist(true)
+	plot.AddPointGroup("Sample1", "lines", []float64{2, 3, 4, 1})
+	plot.Hide()
+	plot.Show()
+	script := plot.DumpScript()
+	expected := "set terminal qt title \"Loss\"\nset terminal qt title \"Loss\" persist\n"
+	if !strings.Contains(script, expected) || !strings.HasSuffix(script, "set terminal qt close\nset terminal qt title \"Loss\" persist\nplot "+plot.PointGroup["Sample1"].plotSpec()+"\nraise\n") {
+		t.Error("Unexpected commands:\n", script)
+	}
+}